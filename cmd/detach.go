@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/spf13/viper"
+)
+
+// detachDir is where the detached helper keeps its pid file and log
+func detachDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "kpf")
+}
+
+// detachedPIDPath returns the pid file of the detached helper
+func detachedPIDPath() string {
+	return filepath.Join(detachDir(), "detached.pid")
+}
+
+// portReleaseTimeout bounds how long a detach waits for the TUI's listeners to
+// close, and an adoption for the helper to exit
+const portReleaseTimeout = 5 * time.Second
+
+// spawnDetached starts a background `kpf forward` process that keeps the
+// given forwards running on the same local ports after the TUI exits. The
+// helper writes its pid file itself, so adoptDetached can find and stop it.
+func spawnDetached(forwards []portforward.SavedForward) (int, error) {
+	if detachDir() == "" {
+		return 0, errors.New("cannot detach: no home directory for the pid file")
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return 0, fmt.Errorf("cannot detach: %w", err)
+	}
+
+	bindAddress := viper.GetString("bind-address")
+	ports := make([]int, 0, len(forwards))
+	args := []string{"forward", "--pid-file", detachedPIDPath(),
+		"--bind-address", bindAddress,
+		"--forward-timeout", viper.GetDuration("forward-timeout").String()}
+	if kubeconfig := viper.GetString("kubeconfig"); kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	if kubeContext := viper.GetString("context"); kubeContext != "" {
+		args = append(args, "--context", kubeContext)
+	}
+	if logFile := viper.GetString("log-file"); logFile != "" {
+		args = append(args, "--log-file", logFile)
+	}
+	for _, sf := range forwards {
+		args = append(args, fmt.Sprintf("%s/%s:%d:%d", sf.Namespace, sf.Service, sf.RemotePort, sf.LocalPort))
+		ports = append(ports, sf.LocalPort)
+	}
+
+	if err := os.MkdirAll(detachDir(), 0o755); err != nil {
+		return 0, fmt.Errorf("cannot detach: %w", err)
+	}
+	logFile, err := os.OpenFile(filepath.Join(detachDir(), "detached.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("cannot detach: %w", err)
+	}
+	defer logFile.Close()
+
+	// The TUI just stopped its own forwards, wait for their listeners to
+	// close so the helper gets the same ports
+	waitForPorts(bindAddress, ports, portReleaseTimeout)
+
+	helper := exec.Command(exe, args...)
+	helper.Stdout = logFile
+	helper.Stderr = logFile
+	helper.SysProcAttr = detachSysProcAttr()
+	if err := helper.Start(); err != nil {
+		return 0, fmt.Errorf("cannot detach: %w", err)
+	}
+	pid := helper.Process.Pid
+	helper.Process.Release()
+	return pid, nil
+}
+
+// adoptDetached stops the helper left running by a detached session, so this
+// session can restore its forwards from the state file. It reports whether a
+// helper was running.
+func adoptDetached() (bool, error) {
+	path := detachedPIDPath()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		os.Remove(path)
+		return false, nil
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil || !processRunning(process) {
+		// The helper is gone, e.g. after a reboot
+		os.Remove(path)
+		return false, nil
+	}
+
+	if err := stopProcess(process, portReleaseTimeout); err != nil {
+		return false, fmt.Errorf("failed to stop the detached forwards (pid %d): %w", pid, err)
+	}
+	os.Remove(path)
+	return true, nil
+}
+
+// waitForPorts waits until a forward to bindAddress could listen on every port
+// again, or the timeout passes
+func waitForPorts(bindAddress string, ports []int, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for _, port := range ports {
+		for !portforward.IsPortAvailable(bindAddress, port) && time.Now().Before(deadline) {
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+}
+
+// writePIDFile records the current process in path for adoptDetached
+func writePIDFile(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strconv.Itoa(os.Getpid())+"\n"), 0o644)
+}
@@ -0,0 +1,34 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// detachSysProcAttr starts the helper in its own session so it survives the
+// terminal closing
+func detachSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+// processRunning reports whether a process found by os.FindProcess, which
+// always succeeds on Unix, still exists
+func processRunning(process *os.Process) bool {
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// stopProcess asks process to stop its forwards and waits up to timeout for
+// it to exit
+func stopProcess(process *os.Process, timeout time.Duration) error {
+	if err := process.Signal(syscall.SIGTERM); err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	for processRunning(process) && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	return nil
+}
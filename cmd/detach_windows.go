@@ -0,0 +1,35 @@
+//go:build windows
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// detachedProcess is the DETACHED_PROCESS creation flag, which syscall
+// doesn't define
+const detachedProcess = 0x00000008
+
+// detachSysProcAttr starts the helper without a console so it survives the
+// terminal closing
+func detachSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP | detachedProcess}
+}
+
+// processRunning reports whether a process found by os.FindProcess still
+// exists. FindProcess already fails for processes that are gone on Windows.
+func processRunning(process *os.Process) bool {
+	return true
+}
+
+// stopProcess kills process, Windows has no SIGTERM. Its listeners close
+// with it.
+func stopProcess(process *os.Process, timeout time.Duration) error {
+	if err := process.Kill(); err != nil {
+		return err
+	}
+	_, err := process.Wait()
+	return err
+}
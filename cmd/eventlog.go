@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/spf13/viper"
+)
+
+// startEventLog appends the forward events of source to --log-file as
+// JSON lines, exiting if the file cannot be opened. The returned function
+// writes the remaining events and closes the file; it does nothing when no
+// log file is configured.
+func startEventLog(source portforward.EventSource) func() {
+	path := viper.GetString("log-file")
+	if path == "" {
+		return func() {}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open log file: %v\n", err)
+		os.Exit(1)
+	}
+
+	events, unsubscribe := source.Subscribe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := portforward.WriteEvents(file, events); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write log file: %v\n", err)
+			// Keep draining so the subscription doesn't just drop events
+			for range events {
+			}
+		}
+	}()
+
+	return func() {
+		unsubscribe()
+		<-done
+		file.Close()
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var pidFile string
+
+var forwardCmd = &cobra.Command{
+	Use:   "forward namespace/service:port[:localPort]...",
+	Short: "Forward service ports without the TUI",
+	Long: `Forward one or more service ports like kubectl port-forward, printing the
+local port of each forward and blocking until interrupted.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runForward(args)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(forwardCmd)
+
+	// Used by detached sessions so the next kpf launch can find the helper
+	forwardCmd.Flags().StringVar(&pidFile, "pid-file", "", "write the process id to this file while forwarding")
+	forwardCmd.Flags().MarkHidden("pid-file")
+}
+
+func runForward(args []string) {
+	var specs []portforward.SavedForward
+	for _, arg := range args {
+		sf, err := portforward.ParseForwardSpec(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		specs = append(specs, sf)
+	}
+
+	client, err := k8s.NewClient(viper.GetString("kubeconfig"), viper.GetString("context"), viper.GetString("namespace"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create Kubernetes client: %v\n", err)
+		os.Exit(1)
+	}
+
+	forwardManager, warning := newForwardManager(client)
+	if warning != "" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+	stopEventLog := startEventLog(forwardManager)
+	defer stopEventLog()
+	defer forwardManager.StopAll()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	started := 0
+	for _, sf := range specs {
+		localPort, err := forwardManager.StartForwardWithLocalPort(ctx, sf.Namespace, sf.Service, sf.RemotePort, sf.LocalPort)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to forward %s/%s:%d: %v\n", sf.Namespace, sf.Service, sf.RemotePort, err)
+			continue
+		}
+		started++
+
+		bindAddress := forwardManager.BindAddress
+		if info := forwardManager.GetForwardInfo(sf.Namespace, sf.Service, sf.RemotePort); info != nil {
+			bindAddress = info.BindAddress
+		}
+		fmt.Printf("Forwarding %s/%s:%d -> %s:%d\n", sf.Namespace, sf.Service, sf.RemotePort, bindAddress, localPort)
+	}
+
+	if started == 0 {
+		forwardManager.StopAll()
+		stopEventLog()
+		os.Exit(1)
+	}
+
+	if pidFile != "" {
+		if err := writePIDFile(pidFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write pid file: %v\n", err)
+		}
+		defer os.Remove(pidFile)
+	}
+
+	<-ctx.Done()
+	fmt.Println("Stopping forwards")
+}
+
+// newForwardManager creates a forward manager configured from the flags,
+// exiting if the bind address or conflict policy is invalid. The warning
+// explains why forwards fall back to localhost, if they do.
+func newForwardManager(client *k8s.Client) (*portforward.Manager, string) {
+	bindAddress := viper.GetString("bind-address")
+	if err := portforward.ValidateBindAddress(bindAddress); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	warning := ""
+	bindAddress, err := portforward.ResolveBindAddress(bindAddress)
+	if err != nil {
+		warning = err.Error()
+	}
+
+	onConflict, err := portforward.ParseConflictPolicy(viper.GetString("on-conflict"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	forwardManager := portforward.NewManager(client)
+	forwardManager.ReadyTimeout = viper.GetDuration("forward-timeout")
+	forwardManager.BindAddress = bindAddress
+	forwardManager.OnConflict = onConflict
+	forwardManager.VerifyForward = viper.GetBool("verify-forward")
+	return forwardManager, warning
+}
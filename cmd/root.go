@@ -1,19 +1,50 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/grumpylabs/kpf/internal/tui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	kubeconfig string
-	namespace  string
-	appVersion string = "dev"
-	appCommit  string = "unknown"
-	appDate    string = "unknown"
+	kubeconfig     string
+	kubeContext    string
+	namespace      string
+	forwardTimeout time.Duration
+	bindAddress    string
+	watch          bool
+	apiAddr        string
+	configFile     string
+	forwards       []string
+	cacheTTL       time.Duration
+	localPortMap   []string
+	exportDir      string
+	exportCSV      bool
+	colorByNS      bool
+	onConflict     string
+	verifyForward  bool
+	detach         bool
+	refreshEvery   time.Duration
+	envTemplate    string
+	includeNS      []string
+	excludeNS      []string
+	privileged     bool
+	logFile        string
+	readOnly       bool
+	selector       string
+	demoMode       bool
+	groupByLabel   string
+	appVersion     string = "dev"
+	appCommit      string = "unknown"
+	appDate        string = "unknown"
 )
 
 func SetVersionInfo(version, commit, date string) {
@@ -41,20 +72,78 @@ func Execute() {
 func init() {
 	cobra.OnInitialize(initConfig)
 
-	rootCmd.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig file (default: $HOME/.kube/config)")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "path to config file (default: $HOME/.config/kpf/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig file (default: the files in $KUBECONFIG, or $HOME/.kube/config)")
+	rootCmd.PersistentFlags().StringVar(&kubeContext, "context", "", "kubeconfig context to use (default: the kubeconfig's current-context)")
 	rootCmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "", "kubernetes namespace (default: all namespaces)")
+	rootCmd.PersistentFlags().DurationVar(&forwardTimeout, "forward-timeout", portforward.DefaultReadyTimeout, "how long to wait for a port forward to become ready")
+
+	rootCmd.PersistentFlags().StringVar(&bindAddress, "bind-address", portforward.DefaultBindAddress, "local address to bind forwards to (e.g. ::1 for IPv6 loopback only, 0.0.0.0 to allow remote access)")
+	rootCmd.PersistentFlags().StringVar(&onConflict, "on-conflict", string(portforward.ConflictPrompt), "what to do when a preferred local port is taken: prompt for another port, or auto to use the next free one")
+	rootCmd.PersistentFlags().BoolVar(&verifyForward, "verify-forward", false, "probe new forwards through their local port and fail them if the pod doesn't accept connections")
+	rootCmd.PersistentFlags().BoolVarP(&watch, "watch", "w", false, "keep the service list up to date using the Kubernetes watch API")
+	rootCmd.Flags().StringArrayVar(&forwards, "forward", nil, "forward namespace/service:port[:localPort] on startup (repeatable)")
+	rootCmd.Flags().DurationVar(&refreshEvery, "refresh-interval", 0, "reload the service list this often, e.g. 30s (0 disables it; space pauses it)")
+	rootCmd.Flags().StringVarP(&selector, "selector", "l", "", "only list services matching this label selector, e.g. 'tier=frontend' (like kubectl -l)")
+	rootCmd.Flags().StringSliceVar(&includeNS, "include-namespaces", nil, "only list services of namespaces matching these glob patterns when showing all namespaces, e.g. 'team-*'")
+	rootCmd.Flags().StringSliceVar(&excludeNS, "exclude-namespaces", nil, "never list services of namespaces matching these glob patterns, e.g. 'kube-*'")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", k8s.DefaultCacheTTL, "reuse service listings for this long across refreshes (0 disables caching)")
+	rootCmd.Flags().StringArrayVar(&localPortMap, "local-port-map", nil, "always use a local port for a service port, as namespace/service:port=localPort (repeatable)")
+	rootCmd.Flags().StringVar(&exportDir, "export-dir", ".", "directory the table export (e) is written to")
+	rootCmd.Flags().BoolVar(&exportCSV, "export-csv", false, "also write a CSV file when exporting the table")
+	rootCmd.Flags().StringVar(&envTemplate, "env-template", tui.DefaultEnvTemplate, "Go template naming the env vars copied with Y, e.g. '{{env .Namespace}}_{{env .Service}}_URL'")
+	rootCmd.Flags().BoolVar(&colorByNS, "color-by-namespace", false, "color the namespace column of the table by namespace")
+	rootCmd.Flags().StringVar(&groupByLabel, "group-by-label", "", "group the table by the value of this service label, e.g. 'project' (g toggles it)")
+	rootCmd.Flags().BoolVar(&privileged, "allow-privileged-ports", false, "accept local ports below 1024 in the port prompt (needs root or CAP_NET_BIND_SERVICE)")
+	rootCmd.Flags().BoolVar(&readOnly, "read-only", false, "browse services without being able to start forwards")
+	rootCmd.Flags().BoolVar(&demoMode, "demo", false, "show a made up cluster with simulated forwards instead of connecting to Kubernetes")
+	rootCmd.Flags().BoolVar(&detach, "detach", false, "keep active forwards running in a background process after quitting, until the next kpf launch")
+	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", "append forward lifecycle events (started, stopped, failed, reconnected) to this file as JSON lines")
+	rootCmd.PersistentFlags().StringVar(&apiAddr, "api-addr", "", "serve the active forwards as JSON on this address (e.g. :8099, disabled by default)")
 
 	viper.BindPFlag("kubeconfig", rootCmd.PersistentFlags().Lookup("kubeconfig"))
+	viper.BindPFlag("context", rootCmd.PersistentFlags().Lookup("context"))
 	viper.BindPFlag("namespace", rootCmd.PersistentFlags().Lookup("namespace"))
+	viper.BindPFlag("forward-timeout", rootCmd.PersistentFlags().Lookup("forward-timeout"))
+	viper.BindPFlag("bind-address", rootCmd.PersistentFlags().Lookup("bind-address"))
+	viper.BindPFlag("on-conflict", rootCmd.PersistentFlags().Lookup("on-conflict"))
+	viper.BindPFlag("verify-forward", rootCmd.PersistentFlags().Lookup("verify-forward"))
+	viper.BindPFlag("watch", rootCmd.PersistentFlags().Lookup("watch"))
+	viper.BindPFlag("local-port-map", rootCmd.Flags().Lookup("local-port-map"))
+	viper.BindPFlag("export-dir", rootCmd.Flags().Lookup("export-dir"))
+	viper.BindPFlag("export-csv", rootCmd.Flags().Lookup("export-csv"))
+	viper.BindPFlag("env-template", rootCmd.Flags().Lookup("env-template"))
+	viper.BindPFlag("color-by-namespace", rootCmd.Flags().Lookup("color-by-namespace"))
+	viper.BindPFlag("group-by-label", rootCmd.Flags().Lookup("group-by-label"))
+	viper.BindPFlag("allow-privileged-ports", rootCmd.Flags().Lookup("allow-privileged-ports"))
+	viper.BindPFlag("detach", rootCmd.Flags().Lookup("detach"))
+	viper.BindPFlag("read-only", rootCmd.Flags().Lookup("read-only"))
+	viper.BindPFlag("demo", rootCmd.Flags().Lookup("demo"))
+	viper.BindPFlag("refresh-interval", rootCmd.Flags().Lookup("refresh-interval"))
+	viper.BindPFlag("selector", rootCmd.Flags().Lookup("selector"))
+	viper.BindPFlag("include-namespaces", rootCmd.Flags().Lookup("include-namespaces"))
+	viper.BindPFlag("exclude-namespaces", rootCmd.Flags().Lookup("exclude-namespaces"))
+	viper.BindPFlag("cache-ttl", rootCmd.PersistentFlags().Lookup("cache-ttl"))
+	viper.BindPFlag("api-addr", rootCmd.PersistentFlags().Lookup("api-addr"))
+	viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
 }
 
 func initConfig() {
 	viper.SetEnvPrefix("KPF")
 	viper.AutomaticEnv()
 
-	if kubeconfig == "" {
-		if home := os.Getenv("HOME"); home != "" {
-			viper.SetDefault("kubeconfig", fmt.Sprintf("%s/.kube/config", home))
+	// Flags and env vars take precedence over values from the config file
+	if configFile != "" {
+		viper.SetConfigFile(configFile)
+	} else if home := os.Getenv("HOME"); home != "" {
+		viper.AddConfigPath(filepath.Join(home, ".config", "kpf"))
+		viper.SetConfigName("config")
+	}
+
+	if err := viper.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) {
+			fmt.Fprintf(os.Stderr, "Warning: failed to read config file: %v\n", err)
 		}
 	}
 }
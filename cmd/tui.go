@@ -1,11 +1,18 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grumpylabs/kpf/internal/api"
+	"github.com/grumpylabs/kpf/internal/demo"
 	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
 	"github.com/grumpylabs/kpf/internal/tui"
 	"github.com/spf13/viper"
 )
@@ -14,14 +21,16 @@ func runTUI() {
 	kubeconfigPath := viper.GetString("kubeconfig")
 	namespace := viper.GetString("namespace")
 
-	// Set environment variable for the TUI to pick up
-	if namespace != "" {
-		os.Setenv("KPF_NAMESPACE", namespace)
+	readOnly := viper.GetBool("read-only")
+	if readOnly && (len(forwards) > 0 || viper.GetBool("detach")) {
+		fmt.Fprintln(os.Stderr, "--forward and --detach cannot be used with --read-only")
+		os.Exit(1)
 	}
 
-	client, err := k8s.NewClient(kubeconfigPath, namespace)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to create Kubernetes client: %v\n", err)
+	// Simulated forwards can't be handed to a detached process
+	demoMode := viper.GetBool("demo")
+	if demoMode && viper.GetBool("detach") {
+		fmt.Fprintln(os.Stderr, "--detach cannot be used with --demo")
 		os.Exit(1)
 	}
 
@@ -31,11 +40,148 @@ func runTUI() {
 		shortCommit = shortCommit[:7]
 	}
 	
-	model := tui.NewModel(client, kubeconfigPath, shortCommit)
-	program := tea.NewProgram(model, tea.WithAltScreen())
+	var startupForwards []portforward.SavedForward
+	for _, spec := range forwards {
+		sf, err := portforward.ParseForwardSpec(spec)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		startupForwards = append(startupForwards, sf)
+	}
+
+	localPorts := portforward.LocalPorts{}
+	for _, spec := range viper.GetStringSlice("local-port-map") {
+		key, localPort, err := portforward.ParseLocalPortMapping(spec)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		localPorts[key] = localPort
+	}
+
+	envTemplate, err := tui.ParseEnvTemplate(viper.GetString("env-template"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	var (
+		client         tui.ServiceLister
+		forwardManager portforward.Backend
+		kubeconfig     string
+		warning        string
+	)
+	if demoMode {
+		client = demo.NewClient(namespace)
+		forwardManager = demo.NewForwarder()
+		kubeconfig = demo.ContextName
+	} else {
+		k8sClient := newClient(kubeconfigPath, namespace)
+		var manager *portforward.Manager
+		manager, warning = newForwardManager(k8sClient)
+		client, forwardManager, kubeconfig = k8sClient, manager, k8sClient.Kubeconfig()
+	}
+	stopEventLog := startEventLog(forwardManager)
+
+	// Take over the forwards a detached session left running; they are
+	// restored from the state file once the helper has released their ports.
+	// A read-only or demo session leaves them running.
+	if !readOnly && !demoMode {
+		if _, err := adoptDetached(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+
+	// Stopped explicitly rather than deferred, the error paths below exit
+	// without running deferred calls
+	stopServer := func() {}
+	if addr := viper.GetString("api-addr"); addr != "" {
+		server, err := api.NewServer(addr, forwardManager)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to start API server: %v\n", err)
+			os.Exit(1)
+		}
+		go server.Serve()
+		stopServer = func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			server.Shutdown(ctx)
+		}
+	}
+
+	model := tui.NewModel(client, forwardManager, kubeconfig, namespace, shortCommit, tui.Options{
+		Watch:                viper.GetBool("watch"),
+		RefreshInterval:      viper.GetDuration("refresh-interval"),
+		ForwardTimeout:       viper.GetDuration("forward-timeout"),
+		KeyBindings:          viper.GetStringMapStringSlice("keys"),
+		Forwards:             startupForwards,
+		LocalPorts:           localPorts,
+		ExportDir:            viper.GetString("export-dir"),
+		ExportCSV:            viper.GetBool("export-csv"),
+		EnvTemplate:          envTemplate,
+		ColorByNamespace:     viper.GetBool("color-by-namespace"),
+		GroupByLabel:         viper.GetString("group-by-label"),
+		Detach:               viper.GetBool("detach"),
+		AllowPrivilegedPorts: viper.GetBool("allow-privileged-ports"),
+		ReadOnly:             readOnly,
+		StartupWarning:       warning,
+	})
+	// Handle SIGINT/SIGTERM ourselves so the model shuts forwards down and
+	// saves state before quitting, instead of bubbletea quitting directly
+	program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion(), tea.WithoutSignalHandler())
 
-	if _, err := program.Run(); err != nil {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		if _, ok := <-signals; ok {
+			program.Send(tui.ShutdownMsg{})
+		}
+	}()
+
+	finalModel, err := program.Run()
+
+	// The model normally stops everything on quit; StopAll is idempotent and
+	// makes sure no listener outlives the process if the TUI exited early
+	forwardManager.StopAll()
+	stopEventLog()
+	stopServer()
+
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
 		os.Exit(1)
 	}
+
+	if m, ok := finalModel.(*tui.Model); ok && len(m.DetachedForwards()) > 0 {
+		detached := m.DetachedForwards()
+		pid, err := spawnDetached(detached)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Detached %d forwards (pid %d); run kpf again to take them back\n", len(detached), pid)
+	}
+}
+
+// newClient creates the Kubernetes client of the TUI, exiting on bad flags
+func newClient(kubeconfigPath, namespace string) *k8s.Client {
+	client, err := k8s.NewClient(kubeconfigPath, viper.GetString("context"), namespace)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create Kubernetes client: %v\n", err)
+		os.Exit(1)
+	}
+	client.SetCacheTTL(viper.GetDuration("cache-ttl"))
+	if err := client.SetNamespaceFilter(k8s.NamespaceFilter{
+		Include: viper.GetStringSlice("include-namespaces"),
+		Exclude: viper.GetStringSlice("exclude-namespaces"),
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	if err := client.SetLabelSelector(viper.GetString("selector")); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	return client
 }
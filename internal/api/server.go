@@ -0,0 +1,131 @@
+// Package api exposes the port forward manager over a small local HTTP/JSON API
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+// Forward is the JSON representation of a single port forward
+type Forward struct {
+	Namespace     string    `json:"namespace"`
+	Service       string    `json:"service"`
+	RemotePort    int       `json:"remotePort"`
+	LocalPort     int       `json:"localPort"`
+	BindAddress   string    `json:"bindAddress"`
+	Pod           string    `json:"pod,omitempty"`
+	State         string    `json:"state"`
+	StartedAt     time.Time `json:"startedAt"`
+	FailureReason string    `json:"failureReason,omitempty"`
+	BytesIn       int64     `json:"bytesIn"`
+	BytesOut      int64     `json:"bytesOut"`
+}
+
+// Server serves the forwards API
+type Server struct {
+	manager  portforward.Forwarder
+	server   *http.Server
+	listener net.Listener
+}
+
+// NewServer listens on addr so that address errors surface before the TUI starts
+func NewServer(addr string, manager portforward.Forwarder) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	s := &Server{
+		manager:  manager,
+		listener: listener,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /forwards", s.listForwards)
+	mux.HandleFunc("DELETE /forwards/{namespace}/{service}/{port}", s.stopForward)
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return s, nil
+}
+
+// Addr returns the address the server is listening on
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Serve handles requests until Shutdown is called
+func (s *Server) Serve() error {
+	if err := s.server.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops the server, waiting for in-flight requests until ctx is done
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) listForwards(w http.ResponseWriter, r *http.Request) {
+	all := s.manager.GetAllForwards()
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	forwards := make([]Forward, 0, len(keys))
+	for _, k := range keys {
+		fw := all[k]
+		forwards = append(forwards, Forward{
+			Namespace:     fw.Namespace,
+			Service:       fw.Service,
+			RemotePort:    fw.RemotePort,
+			LocalPort:     fw.LocalPort,
+			BindAddress:   fw.BindAddress,
+			Pod:           fw.PodName,
+			State:         fw.ForwardingState.String(),
+			StartedAt:     fw.StartedAt,
+			FailureReason: fw.FailureReason,
+			BytesIn:       fw.BytesIn,
+			BytesOut:      fw.BytesOut,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, forwards)
+}
+
+func (s *Server) stopForward(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	service := r.PathValue("service")
+	port, err := strconv.Atoi(r.PathValue("port"))
+	if err != nil || port < 1 || port > 65535 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid port"})
+		return
+	}
+
+	if s.manager.GetForwardInfo(namespace, service, port) == nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "forward not found"})
+		return
+	}
+
+	s.manager.StopForward(namespace, service, port)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
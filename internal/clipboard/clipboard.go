@@ -0,0 +1,50 @@
+package clipboard
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// command describes an external program that reads clipboard contents from stdin
+type command struct {
+	name string
+	args []string
+}
+
+// candidates returns the clipboard programs to try for the current platform
+func candidates() []command {
+	switch runtime.GOOS {
+	case "darwin":
+		return []command{{name: "pbcopy"}}
+	case "windows":
+		return []command{{name: "clip"}}
+	default:
+		return []command{
+			{name: "wl-copy"},
+			{name: "xclip", args: []string{"-selection", "clipboard"}},
+			{name: "xsel", args: []string{"--clipboard", "--input"}},
+			{name: "clip.exe"}, // WSL
+		}
+	}
+}
+
+// Write copies text to the system clipboard using the first available
+// platform clipboard program
+func Write(text string) error {
+	for _, c := range candidates() {
+		path, err := exec.LookPath(c.name)
+		if err != nil {
+			continue
+		}
+
+		cmd := exec.Command(path, c.args...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to copy to clipboard with %s: %w", c.name, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("no clipboard program found for %s", runtime.GOOS)
+}
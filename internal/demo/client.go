@@ -0,0 +1,354 @@
+// Package demo provides a canned cluster and simulated port forwards, so the
+// TUI can be tried out and screenshotted without a Kubernetes cluster.
+package demo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// ContextName is the kubeconfig context the demo cluster reports
+const ContextName = "demo"
+
+// podSuffixes name the replicas backing each service with a selector
+var podSuffixes = []string{"5d8f7c9b4-x7k2q", "5d8f7c9b4-m4p9z"}
+
+// demoService describes one canned service
+type demoService struct {
+	namespace string
+	name      string
+	kind      string
+	ports     []k8s.PortInfo
+	age       time.Duration
+	project   string // Value of the project label, none if empty
+
+	// selectorless services have no pods, forwards to them fail; endpointIP
+	// is the address their manually managed endpoints point at, if any
+	selectorless bool
+	endpointIP   string
+}
+
+var services = []demoService{
+	{namespace: "default", name: "kubernetes", kind: "ClusterIP", age: 90 * 24 * time.Hour, selectorless: true, endpointIP: "172.18.0.2", ports: []k8s.PortInfo{
+		{Name: "https", Port: 443, TargetPort: 6443, Protocol: "TCP"},
+	}},
+	{namespace: "shop", name: "frontend", project: "storefront", kind: "LoadBalancer", age: 12 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "http", Port: 80, TargetPort: 8080, Protocol: "TCP", NodePort: 31080},
+	}},
+	{namespace: "shop", name: "api", project: "storefront", kind: "ClusterIP", age: 12 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "http", Port: 8080, TargetPort: 8080, Protocol: "TCP"},
+		{Name: "grpc", Port: 9090, TargetPort: 9090, Protocol: "TCP"},
+	}},
+	{namespace: "shop", name: "postgres", project: "storefront", kind: "ClusterIP", age: 40 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "postgres", Port: 5432, TargetPort: 5432, Protocol: "TCP"},
+	}},
+	{namespace: "shop", name: "redis", project: "storefront", kind: "ClusterIP", age: 40 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "redis", Port: 6379, TargetPort: 6379, Protocol: "TCP"},
+	}},
+	{namespace: "shop", name: "payments-legacy", project: "payments", kind: "ClusterIP", age: 200 * 24 * time.Hour, selectorless: true, ports: []k8s.PortInfo{
+		{Name: "http", Port: 8443, TargetPort: 8443, Protocol: "TCP"},
+	}},
+	{namespace: "monitoring", name: "grafana", project: "observability", kind: "ClusterIP", age: 5 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "http", Port: 3000, TargetPort: 3000, Protocol: "TCP"},
+	}},
+	{namespace: "monitoring", name: "prometheus", project: "observability", kind: "ClusterIP", age: 5 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "http-web", Port: 9090, TargetPort: 9090, Protocol: "TCP"},
+	}},
+	{namespace: "monitoring", name: "statsd", project: "observability", kind: "ClusterIP", age: 5 * 24 * time.Hour, ports: []k8s.PortInfo{
+		{Name: "metrics", Port: 8125, TargetPort: 8125, Protocol: "UDP"},
+	}},
+}
+
+// Client serves the canned demo cluster. It implements the same listing
+// methods as k8s.Client.
+type Client struct {
+	namespace string
+	created   time.Time
+}
+
+// NewClient returns a client for the demo cluster, limited to namespace
+// unless it is empty
+func NewClient(namespace string) *Client {
+	return &Client{namespace: namespace, created: time.Now()}
+}
+
+// lookup returns the canned service called name in namespace
+func lookup(namespace, name string) (demoService, bool) {
+	for _, svc := range services {
+		if svc.namespace == namespace && svc.name == name {
+			return svc, true
+		}
+	}
+	return demoService{}, false
+}
+
+// labels returns the labels of a canned service
+func labels(svc demoService) map[string]string {
+	labels := map[string]string{"app": svc.name}
+	if svc.project != "" {
+		labels["project"] = svc.project
+	}
+	return labels
+}
+
+// GetServices lists the canned services of the client's namespace
+func (c *Client) GetServices(ctx context.Context) ([]k8s.ServiceInfo, error) {
+	var result []k8s.ServiceInfo
+	for i, svc := range services {
+		if c.namespace != "" && svc.namespace != c.namespace {
+			continue
+		}
+
+		info := k8s.ServiceInfo{
+			Name:      svc.name,
+			Namespace: svc.namespace,
+			Type:      svc.kind,
+			Ports:     append([]k8s.PortInfo(nil), svc.ports...),
+			Service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              svc.name,
+					Namespace:         svc.namespace,
+					Labels:            labels(svc),
+					CreationTimestamp: metav1.NewTime(c.created.Add(-svc.age)),
+				},
+				Spec: corev1.ServiceSpec{ClusterIP: fmt.Sprintf("10.96.%d.%d", i/250, 10+i%250)},
+			},
+			EndpointsKnown: true,
+		}
+		switch {
+		case !svc.selectorless:
+			info.EndpointCount = len(podSuffixes)
+			info.ReadyEndpoints = len(podSuffixes)
+		case svc.endpointIP != "":
+			info.EndpointCount = 1
+			info.ReadyEndpoints = 1
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// WatchServices sends the canned services once; they never change
+func (c *Client) WatchServices(ctx context.Context) (<-chan []k8s.ServiceInfo, error) {
+	updates := make(chan []k8s.ServiceInfo, 1)
+	list, _ := c.GetServices(ctx)
+	updates <- list
+	go func() {
+		<-ctx.Done()
+		close(updates)
+	}()
+	return updates, nil
+}
+
+// InvalidateCache does nothing, the demo cluster isn't cached
+func (c *Client) InvalidateCache() {}
+
+// LabelSelector returns an empty selector, the demo lists every service
+func (c *Client) LabelSelector() string {
+	return ""
+}
+
+// NamespaceFilter returns an empty filter, the demo lists every namespace
+func (c *Client) NamespaceFilter() k8s.NamespaceFilter {
+	return k8s.NamespaceFilter{}
+}
+
+func (c *Client) CurrentContext() string {
+	return ContextName
+}
+
+func (c *Client) ListContexts() ([]string, error) {
+	return []string{ContextName}, nil
+}
+
+// SwitchContext fails for any context but the demo one
+func (c *Client) SwitchContext(name string) error {
+	if name != ContextName {
+		return fmt.Errorf("context %s does not exist in demo mode", name)
+	}
+	return nil
+}
+
+// GetConfig returns a config pointing at a made up API server
+func (c *Client) GetConfig() *rest.Config {
+	return &rest.Config{Host: "https://demo.kpf.local"}
+}
+
+func (c *Client) GetServiceSelector(ctx context.Context, namespace, serviceName string) (string, error) {
+	svc, ok := lookup(namespace, serviceName)
+	if !ok {
+		return "", fmt.Errorf("failed to get service: service %s not found", serviceName)
+	}
+	if svc.selectorless {
+		return "", nil
+	}
+	return "app=" + svc.name, nil
+}
+
+// ResolveServicePortName returns the number of the named port of a service
+func (c *Client) ResolveServicePortName(ctx context.Context, namespace, serviceName, portName string) (int, error) {
+	svc, ok := lookup(namespace, serviceName)
+	if !ok {
+		return 0, fmt.Errorf("failed to get service: service %s not found", serviceName)
+	}
+	for _, port := range svc.ports {
+		if port.Name == portName {
+			return int(port.Port), nil
+		}
+	}
+	return 0, fmt.Errorf("service %s/%s has no port named %s", namespace, serviceName, portName)
+}
+
+// podNames returns the names of the pods backing a service with a selector
+func podNames(svc demoService) []string {
+	if svc.selectorless {
+		return nil
+	}
+	names := make([]string, len(podSuffixes))
+	for i, suffix := range podSuffixes {
+		names[i] = svc.name + "-" + suffix
+	}
+	return names
+}
+
+// pods returns the pods backing a service
+func (c *Client) pods(svc demoService) []k8s.PodInfo {
+	var pods []k8s.PodInfo
+	for _, name := range podNames(svc) {
+		namedPorts := make(map[string]int32)
+		for _, port := range svc.ports {
+			namedPorts[port.Name] = port.TargetPort
+		}
+		pods = append(pods, k8s.PodInfo{
+			Name:       name,
+			Phase:      "Running",
+			Ready:      true,
+			CreatedAt:  c.created.Add(-svc.age / 2),
+			Containers: []k8s.ContainerInfo{{Name: svc.name, NamedPorts: namedPorts}},
+		})
+	}
+	return pods
+}
+
+func (c *Client) GetPodsForService(ctx context.Context, namespace, serviceName string) ([]k8s.PodInfo, error) {
+	svc, ok := lookup(namespace, serviceName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get service: service %s not found", serviceName)
+	}
+	return c.pods(svc), nil
+}
+
+func (c *Client) GetNamespacePods(ctx context.Context, namespace string) ([]k8s.PodInfo, error) {
+	var pods []k8s.PodInfo
+	for _, svc := range services {
+		if svc.namespace == namespace {
+			pods = append(pods, c.pods(svc)...)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods, nil
+}
+
+// GetPodMetrics makes up a fluctuating usage for the pods matching selector
+func (c *Client) GetPodMetrics(ctx context.Context, namespace, selector string) (map[string]k8s.PodMetrics, error) {
+	metrics := make(map[string]k8s.PodMetrics)
+	for _, svc := range services {
+		if svc.namespace != namespace || "app="+svc.name != selector {
+			continue
+		}
+		for _, name := range podNames(svc) {
+			metrics[name] = k8s.PodMetrics{
+				Name:        name,
+				CPUMilli:    20 + rand.Int63n(180),
+				MemoryBytes: (64 + rand.Int63n(192)) << 20,
+			}
+		}
+	}
+	return metrics, nil
+}
+
+func (c *Client) GetDeploymentForService(ctx context.Context, namespace, serviceName string) (*k8s.DeploymentInfo, error) {
+	svc, ok := lookup(namespace, serviceName)
+	if !ok || svc.selectorless {
+		return nil, nil
+	}
+	replicas := int32(len(podSuffixes))
+	return &k8s.DeploymentInfo{
+		Name:            svc.name,
+		Replicas:        replicas,
+		ReadyReplicas:   replicas,
+		UpdatedReplicas: replicas,
+		Image:           fmt.Sprintf("registry.example.com/%s/%s:1.4.2", svc.namespace, svc.name),
+		CreatedAt:       c.created.Add(-svc.age),
+	}, nil
+}
+
+func (c *Client) GetIngresses(ctx context.Context) ([]k8s.IngressInfo, error) {
+	ingresses := []k8s.IngressInfo{
+		{Namespace: "shop", Name: "shop", Host: "shop.example.com", Path: "/", ServiceName: "frontend", ServicePort: 80},
+		{Namespace: "shop", Name: "shop", Host: "shop.example.com", Path: "/api", ServiceName: "api", ServicePortName: "http"},
+		{Namespace: "monitoring", Name: "grafana", Host: "grafana.example.com", Path: "/", ServiceName: "grafana", ServicePort: 3000},
+	}
+	if c.namespace == "" {
+		return ingresses, nil
+	}
+
+	var filtered []k8s.IngressInfo
+	for _, ingress := range ingresses {
+		if ingress.Namespace == c.namespace {
+			filtered = append(filtered, ingress)
+		}
+	}
+	return filtered, nil
+}
+
+// logMessages are the request lines the simulated pods log
+var logMessages = []string{
+	`"GET /healthz HTTP/1.1" 200 2`,
+	`"GET /api/v1/products HTTP/1.1" 200 5312`,
+	`"POST /api/v1/cart HTTP/1.1" 201 184`,
+	`"GET /api/v1/products/42 HTTP/1.1" 404 19`,
+	`"GET /metrics HTTP/1.1" 200 10842`,
+}
+
+// StreamPodLogs returns the last tailLines of a made up access log, followed
+// by a new line every second until ctx is done
+func (c *Client) StreamPodLogs(ctx context.Context, namespace, podName string, tailLines int64) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	go func() {
+		defer writer.Close()
+
+		line := func(at time.Time) string {
+			return fmt.Sprintf("%s %s %s\n", at.UTC().Format(time.RFC3339), podName, logMessages[rand.Intn(len(logMessages))])
+		}
+		now := time.Now()
+		for i := tailLines; i > 0; i-- {
+			if _, err := io.WriteString(writer, line(now.Add(-time.Duration(i)*time.Second))); err != nil {
+				return
+			}
+		}
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case at := <-ticker.C:
+				if _, err := io.WriteString(writer, line(at)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return reader, nil
+}
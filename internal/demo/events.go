@@ -0,0 +1,58 @@
+package demo
+
+import (
+	"time"
+
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+// eventBuffer is how many events a subscriber may fall behind before further
+// events are dropped for it
+const eventBuffer = 64
+
+// Subscribe returns a channel receiving every forward event from now on, and
+// a function that ends the subscription and closes the channel
+func (f *Forwarder) Subscribe() (<-chan portforward.Event, func()) {
+	ch := make(chan portforward.Event, eventBuffer)
+
+	f.eventsMu.Lock()
+	if f.subscribers == nil {
+		f.subscribers = make(map[chan portforward.Event]struct{})
+	}
+	f.subscribers[ch] = struct{}{}
+	f.eventsMu.Unlock()
+
+	unsubscribe := func() {
+		f.eventsMu.Lock()
+		defer f.eventsMu.Unlock()
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// emit sends an event about fw to all subscribers. The caller must hold f.mu.
+func (f *Forwarder) emit(fw *demoForward, eventType portforward.EventType, reason string) {
+	event := portforward.Event{
+		Time:       time.Now(),
+		Type:       eventType,
+		Namespace:  fw.info.Namespace,
+		Service:    fw.info.Service,
+		RemotePort: fw.info.RemotePort,
+		LocalPort:  fw.info.LocalPort,
+		Pod:        fw.info.PodName,
+		Reason:     reason,
+		Attempt:    fw.info.ReconnectAttempts,
+	}
+
+	f.eventsMu.Lock()
+	defer f.eventsMu.Unlock()
+	for ch := range f.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
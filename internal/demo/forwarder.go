@@ -0,0 +1,456 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+// Simulation timings. Starting a forward takes between minStartDelay and
+// maxStartDelay; every simulationTick active forwards see some traffic and,
+// with a chance of 1 in dropChance, one of them drops and reconnects.
+const (
+	minStartDelay  = 400 * time.Millisecond
+	maxStartDelay  = 1500 * time.Millisecond
+	simulationTick = 2 * time.Second
+	dropChance     = 15
+	reconnectDelay = 1500 * time.Millisecond
+	firstLocalPort = 18000
+)
+
+// Forwarder simulates port forwards to the demo cluster. No local ports are
+// actually opened; forwards go through the same pending, active and failed
+// states as real ones and emit the same events.
+type Forwarder struct {
+	mu       sync.RWMutex
+	forwards map[string]*demoForward
+	nextPort int
+
+	eventsMu    sync.Mutex
+	subscribers map[chan portforward.Event]struct{}
+}
+
+var _ portforward.Backend = (*Forwarder)(nil)
+
+// demoForward is a simulated forward and the cancel function of its start
+type demoForward struct {
+	info   portforward.ForwardInfo
+	cancel context.CancelCauseFunc
+}
+
+// NewForwarder returns a forwarder for the demo cluster and starts simulating
+// traffic and dropped connections
+func NewForwarder() *Forwarder {
+	f := &Forwarder{
+		forwards: make(map[string]*demoForward),
+		nextPort: firstLocalPort,
+	}
+	go f.simulate()
+	return f
+}
+
+func forwardKey(namespace, serviceName string, remotePort int) string {
+	return fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
+}
+
+func (f *Forwarder) StartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error) {
+	return f.StartForwardWithLocalPort(ctx, namespace, serviceName, remotePort, 0)
+}
+
+func (f *Forwarder) StartForwardWithLocalPort(ctx context.Context, namespace, serviceName string, remotePort, preferredLocalPort int) (int, error) {
+	return f.StartForwardToPod(ctx, namespace, serviceName, "", remotePort, preferredLocalPort)
+}
+
+func (f *Forwarder) StartForwardToPod(ctx context.Context, namespace, serviceName, podName string, remotePort, preferredLocalPort int) (int, error) {
+	return f.StartForwardToContainer(ctx, namespace, serviceName, podName, "", remotePort, preferredLocalPort)
+}
+
+// StartForwardToContainer pretends to establish a forward, failing it the way
+// the real manager would for services it cannot forward to
+func (f *Forwarder) StartForwardToContainer(ctx context.Context, namespace, serviceName, podName, container string, remotePort, preferredLocalPort int) (int, error) {
+	key := forwardKey(namespace, serviceName, remotePort)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	fw := &demoForward{
+		info: portforward.ForwardInfo{
+			Namespace:       namespace,
+			Service:         serviceName,
+			RemotePort:      remotePort,
+			StartedAt:       time.Now(),
+			ForwardingState: k8s.ForwardingStatePending,
+			BindAddress:     "127.0.0.1",
+		},
+		cancel: cancel,
+	}
+
+	f.mu.Lock()
+	if _, exists := f.forwards[key]; exists {
+		f.mu.Unlock()
+		return 0, fmt.Errorf("%w for %s", portforward.ErrAlreadyForwarding, key)
+	}
+	f.forwards[key] = fw
+	f.mu.Unlock()
+
+	pod, targetPort, container, resolveErr := resolve(namespace, serviceName, podName, container, remotePort)
+
+	delay := minStartDelay + time.Duration(rand.Int63n(int64(maxStartDelay-minStartDelay)))
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		if errors.Is(context.Cause(ctx), portforward.ErrForwardCancelled) {
+			return 0, portforward.ErrForwardCancelled
+		}
+		f.markFailed(fw, "Context cancelled")
+		return 0, fmt.Errorf("context cancelled")
+	}
+
+	if resolveErr != nil {
+		f.markFailed(fw, failureReason(resolveErr))
+		return 0, resolveErr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.forwards[key] != fw {
+		return 0, portforward.ErrForwardCancelled
+	}
+	fw.info.LocalPort = f.allocateLocalPortLocked(preferredLocalPort, remotePort)
+	fw.info.PodName = pod
+	fw.info.TargetPort = targetPort
+	fw.info.Container = container
+	fw.info.StartedAt = time.Now()
+	fw.info.ForwardingState = k8s.ForwardingStateActive
+	f.emit(fw, portforward.EventStarted, "")
+	return fw.info.LocalPort, nil
+}
+
+// resolve returns the pod, container port and container a forward to a demo
+// service port goes to, or the error the real manager would fail with
+func resolve(namespace, serviceName, podName, container string, remotePort int) (string, int, string, error) {
+	svc, ok := lookup(namespace, serviceName)
+	if !ok {
+		return "", 0, "", fmt.Errorf("failed to get service: service %s not found", serviceName)
+	}
+
+	targetPort := remotePort
+	for _, port := range svc.ports {
+		if int(port.Port) != remotePort {
+			continue
+		}
+		if !port.Forwardable() {
+			return "", 0, "", fmt.Errorf("%s port %d cannot be forwarded, Kubernetes port forwarding only supports TCP", port.Protocol, remotePort)
+		}
+		targetPort = int(port.TargetPort)
+	}
+
+	if podName == "" {
+		switch pods := podNames(svc); {
+		case len(pods) > 0:
+			podName = pods[0]
+		case svc.endpointIP != "":
+			return "", 0, "", fmt.Errorf("%w: the endpoints of service %s point at %s, not at a pod", portforward.ErrNoPod, serviceName, svc.endpointIP)
+		default:
+			return "", 0, "", fmt.Errorf("%w: service %s has no selector and no endpoints", portforward.ErrNoPod, serviceName)
+		}
+	}
+	if container == "" {
+		container = podContainer(namespace, podName)
+	}
+	return podName, targetPort, container, nil
+}
+
+// podContainer returns the container of a demo pod, named after the service
+// the pod backs
+func podContainer(namespace, podName string) string {
+	for _, svc := range services {
+		if svc.namespace != namespace {
+			continue
+		}
+		for _, pod := range podNames(svc) {
+			if pod == podName {
+				return svc.name
+			}
+		}
+	}
+	return ""
+}
+
+// allocateLocalPortLocked picks the preferred local port, else the remote port
+// if it is unprivileged, else the next made up one, skipping ports other
+// forwards have
+func (f *Forwarder) allocateLocalPortLocked(preferred, remotePort int) int {
+	allocated := f.allocatedPortsLocked()
+	for _, port := range []int{preferred, remotePort} {
+		if port >= 1024 {
+			if _, taken := allocated[port]; !taken {
+				return port
+			}
+		}
+	}
+	for {
+		port := f.nextPort
+		f.nextPort++
+		if _, taken := allocated[port]; !taken {
+			return port
+		}
+	}
+}
+
+// failureReason turns an error into a capitalized FailureReason string
+func failureReason(err error) string {
+	reason := err.Error()
+	if reason == "" {
+		return reason
+	}
+	return strings.ToUpper(reason[:1]) + reason[1:]
+}
+
+func (f *Forwarder) markFailed(fw *demoForward, reason string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.markFailedLocked(fw, reason)
+}
+
+func (f *Forwarder) markFailedLocked(fw *demoForward, reason string) {
+	fw.info.ForwardingState = k8s.ForwardingStateFailed
+	fw.info.FailureReason = reason
+	fw.info.FailureTime = time.Now()
+	f.emit(fw, portforward.EventFailed, reason)
+}
+
+// simulate forever adds traffic to active forwards and now and then drops one
+func (f *Forwarder) simulate() {
+	ticker := time.NewTicker(simulationTick)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		f.mu.Lock()
+		var active []*demoForward
+		for _, fw := range f.forwards {
+			if fw.info.ForwardingState != k8s.ForwardingStateActive {
+				continue
+			}
+			fw.info.BytesIn += rand.Int63n(64 << 10)
+			fw.info.BytesOut += rand.Int63n(8 << 10)
+			active = append(active, fw)
+		}
+		if len(active) > 0 && rand.Intn(dropChance) == 0 {
+			fw := active[rand.Intn(len(active))]
+			f.markFailedLocked(fw, "Port forwarding failed: lost connection to pod")
+			go f.reconnect(fw)
+		}
+		f.mu.Unlock()
+	}
+}
+
+// reconnect brings a dropped forward back through the other replica of its
+// service, unless it was stopped meanwhile
+func (f *Forwarder) reconnect(fw *demoForward) {
+	key := forwardKey(fw.info.Namespace, fw.info.Service, fw.info.RemotePort)
+	current := func() bool { return f.forwards[key] == fw }
+
+	time.Sleep(reconnectDelay)
+	f.mu.Lock()
+	if !current() {
+		f.mu.Unlock()
+		return
+	}
+	fw.info.ForwardingState = k8s.ForwardingStatePending
+	fw.info.ReconnectAttempts = 1
+	f.emit(fw, portforward.EventReconnecting, "")
+	f.mu.Unlock()
+
+	time.Sleep(reconnectDelay)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !current() {
+		return
+	}
+	if svc, ok := lookup(fw.info.Namespace, fw.info.Service); ok {
+		for _, pod := range podNames(svc) {
+			if pod != fw.info.PodName {
+				fw.info.PodName = pod
+				break
+			}
+		}
+	}
+	fw.info.ForwardingState = k8s.ForwardingStateActive
+	fw.info.FailureReason = ""
+	fw.info.FailureTime = time.Time{}
+	f.emit(fw, portforward.EventReconnected, "")
+}
+
+// StartForwards starts the given forwards concurrently, reusing each one's
+// local port when set. Failures are joined into the returned error.
+func (f *Forwarder) StartForwards(ctx context.Context, forwards []portforward.SavedForward) error {
+	errs := make([]error, len(forwards))
+	var wg sync.WaitGroup
+	for i, sf := range forwards {
+		wg.Add(1)
+		go func(i int, sf portforward.SavedForward) {
+			defer wg.Done()
+
+			_, err := f.StartForwardWithLocalPort(ctx, sf.Namespace, sf.Service, sf.RemotePort, sf.LocalPort)
+			if err != nil && !errors.Is(err, portforward.ErrAlreadyForwarding) {
+				errs[i] = fmt.Errorf("%s/%s:%d: %w", sf.Namespace, sf.Service, sf.RemotePort, err)
+			}
+		}(i, sf)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// RestartForward stops a forward and starts it again on the same local port
+func (f *Forwarder) RestartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error) {
+	fw := f.GetForwardInfo(namespace, serviceName, remotePort)
+	if fw == nil {
+		return 0, fmt.Errorf("%s/%s:%d is not being forwarded", namespace, serviceName, remotePort)
+	}
+	f.StopForward(namespace, serviceName, remotePort)
+	return f.StartForwardWithLocalPort(ctx, namespace, serviceName, remotePort, fw.LocalPort)
+}
+
+// CancelPending aborts a forward that is still being established. It reports
+// whether a pending forward was found.
+func (f *Forwarder) CancelPending(namespace, serviceName string, remotePort int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := forwardKey(namespace, serviceName, remotePort)
+	fw, exists := f.forwards[key]
+	if !exists || fw.info.ForwardingState != k8s.ForwardingStatePending {
+		return false
+	}
+	fw.cancel(portforward.ErrForwardCancelled)
+	delete(f.forwards, key)
+	f.emit(fw, portforward.EventStopped, "cancelled")
+	return true
+}
+
+func (f *Forwarder) StopForward(namespace, serviceName string, remotePort int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := forwardKey(namespace, serviceName, remotePort)
+	if fw, exists := f.forwards[key]; exists {
+		delete(f.forwards, key)
+		f.emit(fw, portforward.EventStopped, "")
+	}
+}
+
+func (f *Forwarder) StopAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for key, fw := range f.forwards {
+		delete(f.forwards, key)
+		f.emit(fw, portforward.EventStopped, "")
+	}
+}
+
+// ReapForwards stops the forwards in namespace (every namespace when empty)
+// whose service no longer exists according to exists, and returns their keys
+func (f *Forwarder) ReapForwards(namespace string, exists func(namespace, serviceName string) bool) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var reaped []string
+	for key, fw := range f.forwards {
+		if namespace != "" && fw.info.Namespace != namespace {
+			continue
+		}
+		if fw.info.ForwardingState == k8s.ForwardingStatePending || exists(fw.info.Namespace, fw.info.Service) {
+			continue
+		}
+		delete(f.forwards, key)
+		f.emit(fw, portforward.EventStopped, "service deleted")
+		reaped = append(reaped, key)
+	}
+	sort.Strings(reaped)
+	return reaped
+}
+
+func (f *Forwarder) GetForwardInfo(namespace, serviceName string, remotePort int) *portforward.ForwardInfo {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if fw, exists := f.forwards[forwardKey(namespace, serviceName, remotePort)]; exists {
+		info := fw.info
+		return &info
+	}
+	return nil
+}
+
+// GetAllForwards returns copies of all forwards keyed by "namespace/service:port"
+func (f *Forwarder) GetAllForwards() map[string]*portforward.ForwardInfo {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	result := make(map[string]*portforward.ForwardInfo, len(f.forwards))
+	for key, fw := range f.forwards {
+		info := fw.info
+		result[key] = &info
+	}
+	return result
+}
+
+func (f *Forwarder) allocatedPortsLocked() map[int]string {
+	ports := make(map[int]string)
+	for key, fw := range f.forwards {
+		if fw.info.LocalPort > 0 && fw.info.ForwardingState != k8s.ForwardingStateFailed {
+			ports[fw.info.LocalPort] = key
+		}
+	}
+	return ports
+}
+
+// SavedForwards returns the active forwards, sorted like the real manager's
+func (f *Forwarder) SavedForwards() []portforward.SavedForward {
+	f.mu.RLock()
+	saved := make([]portforward.SavedForward, 0, len(f.forwards))
+	for _, fw := range f.forwards {
+		if fw.info.ForwardingState != k8s.ForwardingStateActive {
+			continue
+		}
+		saved = append(saved, portforward.SavedForward{
+			Namespace:  fw.info.Namespace,
+			Service:    fw.info.Service,
+			RemotePort: fw.info.RemotePort,
+			LocalPort:  fw.info.LocalPort,
+		})
+	}
+	f.mu.RUnlock()
+
+	sort.Slice(saved, func(i, j int) bool {
+		return forwardKey(saved[i].Namespace, saved[i].Service, saved[i].RemotePort) <
+			forwardKey(saved[j].Namespace, saved[j].Service, saved[j].RemotePort)
+	})
+	return saved
+}
+
+// SaveState does nothing: demo forwards must not end up in the state of
+// real sessions
+func (f *Forwarder) SaveState(path string) error {
+	return nil
+}
+
+// RestoreState does nothing, see SaveState
+func (f *Forwarder) RestoreState(ctx context.Context, path string) error {
+	return nil
+}
+
+// SaveLocalPorts does nothing, see SaveState
+func (f *Forwarder) SaveLocalPorts(path string, previous portforward.LocalPorts) error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long a service listing is reused before the API
+// server is queried again
+const DefaultCacheTTL = 5 * time.Second
+
+// serviceCache remembers the last service listing for a short time so rapid
+// refreshes don't re-list every namespace
+type serviceCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	services  []ServiceInfo
+	fetchedAt time.Time
+}
+
+// get returns a copy of the cached services if they are still fresh
+func (sc *serviceCache) get() ([]ServiceInfo, bool) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if sc.ttl <= 0 || sc.services == nil || time.Since(sc.fetchedAt) > sc.ttl {
+		return nil, false
+	}
+	return copyServices(sc.services), true
+}
+
+// put stores a copy of services as the latest listing
+func (sc *serviceCache) put(services []ServiceInfo) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if services == nil {
+		services = []ServiceInfo{}
+	}
+	sc.services = copyServices(services)
+	sc.fetchedAt = time.Now()
+}
+
+// invalidate drops the cached listing
+func (sc *serviceCache) invalidate() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.services = nil
+}
+
+// setTTL changes how long listings are reused, 0 disables caching
+func (sc *serviceCache) setTTL(ttl time.Duration) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.ttl = ttl
+}
+
+// copyServices copies services deeply enough that callers can update the
+// per-port forwarding state without touching the cache
+func copyServices(services []ServiceInfo) []ServiceInfo {
+	copied := make([]ServiceInfo, len(services))
+	for i, svc := range services {
+		copied[i] = svc
+		copied[i].Ports = append([]PortInfo(nil), svc.Ports...)
+	}
+	return copied
+}
@@ -2,33 +2,49 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/util/homedir"
 )
 
 type Client struct {
-	clientset *kubernetes.Clientset
-	config    *rest.Config
-	namespace string
+	clientset       kubernetes.Interface
+	config          *rest.Config
+	namespace       string
+	rules           *clientcmd.ClientConfigLoadingRules
+	context         string
+	mu              sync.RWMutex // Guards clientset, config and context across context switches
+	namespaceFilter NamespaceFilter
+	labelSelector   string
+	cache           serviceCache
 }
 
-func NewClient(kubeconfig, namespace string) (*Client, error) {
-	var config *rest.Config
-	var err error
+// NewClient connects to the cluster of the current context. An empty
+// kubeconfig merges the files listed in $KUBECONFIG, or uses ~/.kube/config.
+// A non-empty kubeContext overrides the kubeconfig's current-context.
+func NewClient(kubeconfig, kubeContext, namespace string) (*Client, error) {
+	rules := loadingRules(kubeconfig)
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
 
-	if kubeconfig == "" {
-		kubeconfig = filepath.Join(homedir.HomeDir(), ".kube", "config")
+	config, err := clientConfig.ClientConfig()
+	if err != nil && kubeContext != "" {
+		// Don't fall back to the in-cluster config for a context that was asked for
+		return nil, fmt.Errorf("failed to create config for context %s: %w", kubeContext, err)
 	}
-
-	config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
 		config, err = rest.InClusterConfig()
 		if err != nil {
@@ -41,152 +57,438 @@ func NewClient(kubeconfig, namespace string) (*Client, error) {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
 	}
 
-	return &Client{
+	client := &Client{
 		clientset: clientset,
 		config:    config,
 		namespace: namespace,
-	}, nil
+		rules:     rules,
+		cache:     serviceCache{ttl: DefaultCacheTTL},
+	}
+	if kubeContext != "" {
+		client.context = kubeContext
+	} else if rawConfig, err := clientConfig.RawConfig(); err == nil {
+		client.context = rawConfig.CurrentContext
+	}
+	return client, nil
+}
+
+// loadingRules returns the kubeconfig loading rules for an explicit path, or
+// the default rules merging $KUBECONFIG (falling back to ~/.kube/config) when
+// the path is empty
+func loadingRules(kubeconfig string) *clientcmd.ClientConfigLoadingRules {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	return rules
+}
+
+// Kubeconfig returns the kubeconfig file the client loads, or the list of
+// merged files separated like $KUBECONFIG
+func (c *Client) Kubeconfig() string {
+	if c.rules.ExplicitPath != "" {
+		return c.rules.ExplicitPath
+	}
+	return strings.Join(c.rules.Precedence, string(filepath.ListSeparator))
+}
+
+// ListContexts returns the context names defined in the kubeconfig, sorted
+func (c *Client) ListContexts() ([]string, error) {
+	rawConfig, err := c.rules.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts := make([]string, 0, len(rawConfig.Contexts))
+	for name := range rawConfig.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+	return contexts, nil
+}
+
+// SwitchContext rebuilds the clientset for another kubeconfig context. Callers
+// holding the client keep working against the new cluster.
+func (c *Client) SwitchContext(name string) error {
+	rawConfig, err := c.rules.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	if _, ok := rawConfig.Contexts[name]; !ok {
+		return fmt.Errorf("context %q not found in kubeconfig", name)
+	}
+
+	config, err := clientcmd.NewNonInteractiveClientConfig(*rawConfig, name, &clientcmd.ConfigOverrides{}, c.rules).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create config for context %s: %w", name, err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clientset = clientset
+	c.config = config
+	c.context = name
+	c.cache.invalidate()
+	return nil
+}
+
+// SetCacheTTL sets how long GetServices reuses its last result, 0 disables caching
+func (c *Client) SetCacheTTL(ttl time.Duration) {
+	c.cache.setTTL(ttl)
+}
+
+// SetLabelSelector limits the listed services to those matching selector,
+// e.g. "tier=frontend", on the API server. Empty lists every service.
+func (c *Client) SetLabelSelector(selector string) error {
+	if _, err := labels.Parse(selector); err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", selector, err)
+	}
+	c.mu.Lock()
+	c.labelSelector = selector
+	c.mu.Unlock()
+	c.cache.invalidate()
+	return nil
+}
+
+// LabelSelector returns the selector set with SetLabelSelector
+func (c *Client) LabelSelector() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.labelSelector
+}
+
+// InvalidateCache makes the next GetServices call query the API server
+func (c *Client) InvalidateCache() {
+	c.cache.invalidate()
+}
+
+// CurrentContext returns the kubeconfig context the client is using, or "" if unknown
+func (c *Client) CurrentContext() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.context
 }
 
+// listWorkers bounds how many namespaces are listed concurrently
+const listWorkers = 8
+
+// GetServices lists the services of the client's namespace (or all
+// namespaces), reusing a recent result while it is within the cache TTL
 func (c *Client) GetServices(ctx context.Context) ([]ServiceInfo, error) {
-	var services []ServiceInfo
+	if services, ok := c.cache.get(); ok {
+		return services, nil
+	}
+
+	services, err := c.listServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.put(services)
+	return services, nil
+}
 
+// listServices lists services from the API server
+func (c *Client) listServices(ctx context.Context) ([]ServiceInfo, error) {
 	namespaces := []string{c.namespace}
 	if c.namespace == "" {
-		nsList, err := c.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		nsList, err := c.GetClientset().CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list namespaces: %w", err)
 		}
+		filter := c.NamespaceFilter()
 		namespaces = make([]string, 0, len(nsList.Items))
 		for _, ns := range nsList.Items {
-			namespaces = append(namespaces, ns.Name)
+			if filter.Allows(ns.Name) {
+				namespaces = append(namespaces, ns.Name)
+			}
 		}
 	}
 
-	for _, ns := range namespaces {
-		svcList, err := c.clientset.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list services in namespace %s: %w", ns, err)
+	// Fan the per-namespace lists out over a bounded pool, cancelling the
+	// rest as soon as one fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]ServiceInfo, len(namespaces))
+	errs := make([]error, len(namespaces))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(listWorkers, len(namespaces)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				results[i], errs[i] = c.getNamespaceServices(ctx, namespaces[i])
+				if errs[i] != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range namespaces {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			// Namespaces no worker picked up count as cancelled
+			for j := i; j < len(namespaces); j++ {
+				errs[j] = ctx.Err()
+			}
+			break feed
 		}
+	}
+	close(indexes)
+	wg.Wait()
 
-		for _, svc := range svcList.Items {
-			services = append(services, ServiceInfo{
-				Name:      svc.Name,
-				Namespace: svc.Namespace,
-				Type:      string(svc.Spec.Type),
-				Ports:     extractPorts(&svc),
-				Service:   &svc,
-			})
+	// Report the first real failure rather than the cancellations it caused
+	var firstErr error
+	for _, err := range errs {
+		if err == nil {
+			continue
 		}
+		if firstErr == nil || (errors.Is(firstErr, context.Canceled) && !errors.Is(err, context.Canceled)) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	// Keep the namespace order so results match a sequential listing
+	var services []ServiceInfo
+	for _, nsServices := range results {
+		services = append(services, nsServices...)
+	}
+	return services, nil
+}
+
+// getNamespaceServices lists the services of a single namespace along with
+// their endpoint counts
+func (c *Client) getNamespaceServices(ctx context.Context, ns string) ([]ServiceInfo, error) {
+	svcList, err := c.GetClientset().CoreV1().Services(ns).List(ctx, metav1.ListOptions{LabelSelector: c.LabelSelector()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services in namespace %s: %w", ns, err)
 	}
 
+	// One Endpoints list per namespace instead of a lookup per service.
+	// Counts are best effort, e.g. RBAC may not allow listing endpoints.
+	var endpoints map[string]*corev1.Endpoints
+	if epList, err := c.GetClientset().CoreV1().Endpoints(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		endpoints = make(map[string]*corev1.Endpoints, len(epList.Items))
+		for i := range epList.Items {
+			endpoints[epList.Items[i].Name] = &epList.Items[i]
+		}
+	}
+
+	services := make([]ServiceInfo, 0, len(svcList.Items))
+	for i := range svcList.Items {
+		info := newServiceInfo(&svcList.Items[i])
+		if endpoints != nil {
+			setEndpointCounts(&info, endpoints[info.Name])
+		}
+		services = append(services, info)
+	}
 	return services, nil
 }
 
+// setEndpointCounts records how many ready and total addresses back a service.
+// A nil Endpoints object means the service has no endpoints.
+func setEndpointCounts(info *ServiceInfo, ep *corev1.Endpoints) {
+	info.EndpointsKnown = true
+	info.EndpointCount = 0
+	info.ReadyEndpoints = 0
+	if ep == nil {
+		return
+	}
+	for _, subset := range ep.Subsets {
+		info.ReadyEndpoints += len(subset.Addresses)
+		info.EndpointCount += len(subset.Addresses) + len(subset.NotReadyAddresses)
+	}
+}
+
+// newServiceInfo converts a Kubernetes service into a ServiceInfo
+func newServiceInfo(svc *corev1.Service) ServiceInfo {
+	return ServiceInfo{
+		Name:      svc.Name,
+		Namespace: svc.Namespace,
+		Type:      string(svc.Spec.Type),
+		Ports:     extractPorts(svc),
+		Service:   svc,
+	}
+}
+
 func extractPorts(svc *corev1.Service) []PortInfo {
 	var ports []PortInfo
 	for _, port := range svc.Spec.Ports {
-		ports = append(ports, PortInfo{
+		info := PortInfo{
 			Name:       port.Name,
 			Port:       port.Port,
 			TargetPort: port.TargetPort.IntVal,
+			NodePort:   port.NodePort,
 			Protocol:   string(port.Protocol),
-		})
+		}
+		if port.TargetPort.Type == intstr.String {
+			info.TargetPortName = port.TargetPort.StrVal
+		}
+		ports = append(ports, info)
 	}
 	return ports
 }
 
-func (c *Client) GetDeploymentForService(ctx context.Context, namespace, serviceName string) (*DeploymentInfo, error) {
-	// First get the service to check its selector
-	service, err := c.clientset.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+// GetServiceSelector returns the label selector a service uses to pick its pods.
+// An empty string means the service has no selector (e.g. selectorless services
+// backed by manually managed Endpoints).
+func (c *Client) GetServiceSelector(ctx context.Context, namespace, serviceName string) (string, error) {
+	service, err := c.GetClientset().CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if len(service.Spec.Selector) == 0 {
+		return "", nil
+	}
+	return labels.SelectorFromSet(service.Spec.Selector).String(), nil
+}
+
+// GetServicePort returns the spec of the service port with the given port number
+func (c *Client) GetServicePort(ctx context.Context, namespace, serviceName string, port int) (*corev1.ServicePort, error) {
+	service, err := c.GetClientset().CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	for i := range service.Spec.Ports {
+		if int(service.Spec.Ports[i].Port) == port {
+			return &service.Spec.Ports[i], nil
+		}
+	}
+	return nil, fmt.Errorf("service %s/%s has no port %d", namespace, serviceName, port)
+}
 
-	// Get all deployments in the namespace
-	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+// GetPodsForService returns the pods selected by a service along with their
+// phase, readiness, and restart count
+func (c *Client) GetPodsForService(ctx context.Context, namespace, serviceName string) ([]PodInfo, error) {
+	selector, err := c.GetServiceSelector(ctx, namespace, serviceName)
 	if err != nil {
 		return nil, err
 	}
+	if selector == "" {
+		return nil, nil
+	}
 
-	// Look for a deployment that matches the service
-	for _, dep := range deployments.Items {
-		// Check if deployment name matches service name
-		if dep.Name == serviceName {
-			var replicas int32 = 0
-			if dep.Spec.Replicas != nil {
-				replicas = *dep.Spec.Replicas
-			}
-			return &DeploymentInfo{
-				Name:            dep.Name,
-				Replicas:        replicas,
-				ReadyReplicas:   dep.Status.ReadyReplicas,
-				UpdatedReplicas: dep.Status.UpdatedReplicas,
-				Image:           getMainContainerImage(&dep),
-				CreatedAt:       dep.CreationTimestamp.Time,
-			}, nil
-		}
-		
-		// Check if deployment selector matches service selector
-		if service.Spec.Selector != nil && dep.Spec.Selector != nil {
-			match := true
-			for key, value := range service.Spec.Selector {
-				if depValue, ok := dep.Spec.Selector.MatchLabels[key]; !ok || depValue != value {
-					match = false
-					break
-				}
-			}
-			if match {
-				var replicas int32 = 0
-				if dep.Spec.Replicas != nil {
-					replicas = *dep.Spec.Replicas
-				}
-				return &DeploymentInfo{
-					Name:            dep.Name,
-					Replicas:        replicas,
-					ReadyReplicas:   dep.Status.ReadyReplicas,
-					UpdatedReplicas: dep.Status.UpdatedReplicas,
-					Image:           getMainContainerImage(&dep),
-					CreatedAt:       dep.CreationTimestamp.Time,
-				}, nil
+	pods, err := c.GetClientset().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	podInfos := make([]PodInfo, 0, len(pods.Items))
+	for i := range pods.Items {
+		podInfos = append(podInfos, newPodInfo(&pods.Items[i]))
+	}
+	return podInfos, nil
+}
+
+// GetNamespacePods lists every pod of namespace, to forward services without
+// pods of their own through a pod of another workload
+func (c *Client) GetNamespacePods(ctx context.Context, namespace string) ([]PodInfo, error) {
+	pods, err := c.GetClientset().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	podInfos := make([]PodInfo, 0, len(pods.Items))
+	for i := range pods.Items {
+		podInfos = append(podInfos, newPodInfo(&pods.Items[i]))
+	}
+	return podInfos, nil
+}
+
+// newPodInfo summarizes a pod's health
+func newPodInfo(pod *corev1.Pod) PodInfo {
+	info := PodInfo{
+		Name:      pod.Name,
+		Phase:     string(pod.Status.Phase),
+		CreatedAt: pod.CreationTimestamp.Time,
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			info.Ready = cond.Status == corev1.ConditionTrue
+			break
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		info.Restarts += cs.RestartCount
+	}
+	for _, container := range pod.Spec.Containers {
+		containerInfo := ContainerInfo{Name: container.Name, NamedPorts: make(map[string]int32)}
+		for _, port := range container.Ports {
+			if port.Name != "" {
+				containerInfo.NamedPorts[port.Name] = port.ContainerPort
 			}
 		}
+		info.Containers = append(info.Containers, containerInfo)
+	}
+	return info
+}
+
+func (c *Client) GetDeploymentForService(ctx context.Context, namespace, serviceName string) (*DeploymentInfo, error) {
+	// First get the service to check its selector
+	service, err := c.GetClientset().CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Follow the selected pods up to the workload controlling them, which
+	// works whatever labels the workload's own selector uses
+	if info, err := c.workloadFromPods(ctx, namespace, service.Spec.Selector); err == nil && info != nil {
+		return info, nil
+	}
+
+	// Without running pods, fall back to matching workloads by name or selector
+	deployments, err := c.GetClientset().AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		dep := &deployments.Items[i]
+		if dep.Name == serviceName || selectorMatches(service.Spec.Selector, dep.Spec.Selector) {
+			return deploymentInfo(dep), nil
+		}
 	}
 
 	// Also try StatefulSets if no deployment found
-	statefulsets, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	statefulsets, err := c.GetClientset().AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
 	if err == nil {
-		for _, sts := range statefulsets.Items {
-			if sts.Name == serviceName {
-				var replicas int32 = 0
-				if sts.Spec.Replicas != nil {
-					replicas = *sts.Spec.Replicas
-				}
-				return &DeploymentInfo{
-					Name:            sts.Name + " (StatefulSet)",
-					Replicas:        replicas,
-					ReadyReplicas:   sts.Status.ReadyReplicas,
-					UpdatedReplicas: sts.Status.UpdatedReplicas,
-					Image:           getMainContainerImageFromStatefulSet(&sts),
-					CreatedAt:       sts.CreationTimestamp.Time,
-				}, nil
+		for i := range statefulsets.Items {
+			if statefulsets.Items[i].Name == serviceName {
+				return statefulSetInfo(&statefulsets.Items[i]), nil
 			}
 		}
 	}
 
 	// Also try DaemonSets
-	daemonsets, err := c.clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	daemonsets, err := c.GetClientset().AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
 	if err == nil {
-		for _, ds := range daemonsets.Items {
-			if ds.Name == serviceName {
-				return &DeploymentInfo{
-					Name:            ds.Name + " (DaemonSet)",
-					Replicas:        ds.Status.DesiredNumberScheduled,
-					ReadyReplicas:   ds.Status.NumberReady,
-					UpdatedReplicas: ds.Status.UpdatedNumberScheduled,
-					Image:           getMainContainerImageFromDaemonSet(&ds),
-					CreatedAt:       ds.CreationTimestamp.Time,
-				}, nil
+		for i := range daemonsets.Items {
+			if daemonsets.Items[i].Name == serviceName {
+				return daemonSetInfo(&daemonsets.Items[i]), nil
+			}
+		}
+	}
+
+	// ReplicaSets not managed by one of the above
+	replicasets, err := c.GetClientset().AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		for i := range replicasets.Items {
+			rs := &replicasets.Items[i]
+			if rs.Name == serviceName || selectorMatches(service.Spec.Selector, rs.Spec.Selector) {
+				return replicaSetInfo(rs), nil
 			}
 		}
 	}
@@ -194,6 +496,56 @@ func (c *Client) GetDeploymentForService(ctx context.Context, namespace, service
 	return nil, nil // No deployment found
 }
 
+// selectorMatches reports whether every key of the service selector is part
+// of the workload selector's match labels
+func selectorMatches(serviceSelector map[string]string, selector *metav1.LabelSelector) bool {
+	if len(serviceSelector) == 0 || selector == nil {
+		return false
+	}
+	for key, value := range serviceSelector {
+		if v, ok := selector.MatchLabels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// barePodsInfo summarizes pods that aren't managed by a workload controller
+func barePodsInfo(pods []corev1.Pod) *DeploymentInfo {
+	info := &DeploymentInfo{
+		Replicas:        int32(len(pods)),
+		UpdatedReplicas: int32(len(pods)),
+		Image:           getMainContainerImageFromPodSpec(&pods[0].Spec),
+		CreatedAt:       pods[0].CreationTimestamp.Time,
+		BarePods:        true,
+	}
+
+	names := make([]string, 0, len(pods))
+	for i := range pods {
+		podInfo := newPodInfo(&pods[i])
+		names = append(names, pods[i].Name)
+		if podInfo.Ready {
+			info.ReadyReplicas++
+		}
+		info.Restarts += podInfo.Restarts
+		if pods[i].CreationTimestamp.Time.Before(info.CreatedAt) {
+			info.CreatedAt = pods[i].CreationTimestamp.Time
+		}
+	}
+	info.Name = strings.Join(names, ", ") + " (Pod)"
+	if len(pods) > 1 {
+		info.Name = strings.Join(names, ", ") + " (Pods)"
+	}
+	return info
+}
+
+func getMainContainerImageFromPodSpec(spec *corev1.PodSpec) string {
+	if len(spec.Containers) > 0 {
+		return spec.Containers[0].Image
+	}
+	return "unknown"
+}
+
 func getMainContainerImageFromStatefulSet(sts *appsv1.StatefulSet) string {
 	if len(sts.Spec.Template.Spec.Containers) > 0 {
 		return sts.Spec.Template.Spec.Containers[0].Image
@@ -215,10 +567,14 @@ func getMainContainerImage(dep *appsv1.Deployment) string {
 	return "unknown"
 }
 
-func (c *Client) GetClientset() *kubernetes.Clientset {
+func (c *Client) GetClientset() kubernetes.Interface {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.clientset
 }
 
 func (c *Client) GetConfig() *rest.Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.config
 }
@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeClient returns a client listing all namespaces of a fake cluster
+// with one service in each of n namespaces
+func newFakeClient(n int) (*Client, *fake.Clientset) {
+	var objects []runtime.Object
+	for i := 0; i < n; i++ {
+		ns := fmt.Sprintf("ns-%02d", i)
+		objects = append(objects,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc-" + ns, Namespace: ns}},
+		)
+	}
+	clientset := fake.NewSimpleClientset(objects...)
+	return &Client{clientset: clientset}, clientset
+}
+
+// sequentialServices lists the services namespace by namespace, the order
+// listServices must keep
+func sequentialServices(t *testing.T, c *Client) []string {
+	t.Helper()
+	ctx := context.Background()
+	nsList, err := c.GetClientset().CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, ns := range nsList.Items {
+		services, err := c.getNamespaceServices(ctx, ns.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, svc := range services {
+			names = append(names, svc.Namespace+"/"+svc.Name)
+		}
+	}
+	return names
+}
+
+func TestListServicesKeepsNamespaceOrder(t *testing.T) {
+	c, _ := newFakeClient(3*listWorkers + 1)
+	want := sequentialServices(t, c)
+
+	services, err := c.listServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != len(want) {
+		t.Fatalf("got %d services, want %d", len(services), len(want))
+	}
+	for i, svc := range services {
+		if got := svc.Namespace + "/" + svc.Name; got != want[i] {
+			t.Errorf("service %d is %s, want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestListServicesReportsFirstRealError(t *testing.T) {
+	c, clientset := newFakeClient(3 * listWorkers)
+	errBoom := errors.New("boom")
+	clientset.PrependReactor("list", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "ns-05" {
+			return true, nil, errBoom
+		}
+		return false, nil, nil
+	})
+
+	_, err := c.listServices(context.Background())
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("got error %v, want %v", err, errBoom)
+	}
+}
+
+func TestListServicesReturnsEarlyWhenCancelled(t *testing.T) {
+	c, clientset := newFakeClient(3 * listWorkers)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.listServices(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	for _, action := range clientset.Actions() {
+		if action.GetResource().Resource == "services" {
+			t.Fatalf("listed services in %s after the context was cancelled", action.GetNamespace())
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IngressInfo is a single host/path of an Ingress and the service behind it
+type IngressInfo struct {
+	Namespace       string
+	Name            string
+	Host            string // "*" when the rule matches any host
+	Path            string
+	ServiceName     string // Empty when the backend is not a service
+	ServicePort     int32  // Zero when the backend refers to the port by name
+	ServicePortName string
+	Backend         string // Description of non-service backends, e.g. a resource
+}
+
+// HasService reports whether the entry is backed by a service that can be forwarded
+func (i IngressInfo) HasService() bool {
+	return i.ServiceName != ""
+}
+
+// GetIngresses lists the networking.k8s.io/v1 Ingress rules in the client's
+// namespace (or all namespaces), one entry per host and path
+func (c *Client) GetIngresses(ctx context.Context) ([]IngressInfo, error) {
+	list, err := c.GetClientset().NetworkingV1().Ingresses(c.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	var ingresses []IngressInfo
+	for _, ing := range list.Items {
+		if ing.Spec.DefaultBackend != nil {
+			ingresses = append(ingresses, newIngressInfo(&ing, "*", "(default)", ing.Spec.DefaultBackend))
+		}
+		for _, rule := range ing.Spec.Rules {
+			host := rule.Host
+			if host == "" {
+				host = "*"
+			}
+			if rule.HTTP == nil {
+				// A rule without paths falls through to the default backend
+				continue
+			}
+			for i := range rule.HTTP.Paths {
+				path := rule.HTTP.Paths[i].Path
+				if path == "" {
+					path = "/"
+				}
+				ingresses = append(ingresses, newIngressInfo(&ing, host, path, &rule.HTTP.Paths[i].Backend))
+			}
+		}
+	}
+
+	sort.SliceStable(ingresses, func(i, j int) bool {
+		a, b := ingresses[i], ingresses[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Host != b.Host {
+			return a.Host < b.Host
+		}
+		return a.Path < b.Path
+	})
+	return ingresses, nil
+}
+
+// newIngressInfo describes one backend of an Ingress
+func newIngressInfo(ing *networkingv1.Ingress, host, path string, backend *networkingv1.IngressBackend) IngressInfo {
+	info := IngressInfo{
+		Namespace: ing.Namespace,
+		Name:      ing.Name,
+		Host:      host,
+		Path:      path,
+	}
+
+	switch {
+	case backend.Service != nil:
+		info.ServiceName = backend.Service.Name
+		info.ServicePort = backend.Service.Port.Number
+		info.ServicePortName = backend.Service.Port.Name
+	case backend.Resource != nil:
+		info.Backend = fmt.Sprintf("%s/%s", backend.Resource.Kind, backend.Resource.Name)
+	default:
+		info.Backend = "<no backend>"
+	}
+	return info
+}
+
+// ResolveServicePortName returns the port number of a named service port
+func (c *Client) ResolveServicePortName(ctx context.Context, namespace, serviceName, portName string) (int, error) {
+	service, err := c.GetClientset().CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	for _, port := range service.Spec.Ports {
+		if port.Name == portName {
+			return int(port.Port), nil
+		}
+	}
+	return 0, fmt.Errorf("service %s/%s has no port named %q", namespace, serviceName, portName)
+}
@@ -0,0 +1,23 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StreamPodLogs follows the logs of a pod, starting with its last tailLines
+// lines. The stream ends when ctx is cancelled or the caller closes it.
+func (c *Client) StreamPodLogs(ctx context.Context, namespace, podName string, tailLines int64) (io.ReadCloser, error) {
+	req := c.GetClientset().CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Follow:    true,
+		TailLines: &tailLines,
+	})
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs of pod %s: %w", podName, err)
+	}
+	return stream, nil
+}
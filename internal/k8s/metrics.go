@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// ErrMetricsUnavailable is returned when the cluster doesn't serve the
+// metrics.k8s.io API, e.g. because metrics-server isn't installed
+var ErrMetricsUnavailable = errors.New("metrics-server unavailable")
+
+// PodMetrics is the current resource usage of a pod, summed over its containers
+type PodMetrics struct {
+	Name        string
+	CPUMilli    int64 // Millicores
+	MemoryBytes int64
+}
+
+// GetPodMetrics returns the usage of the pods in namespace matching selector,
+// by pod name
+func (c *Client) GetPodMetrics(ctx context.Context, namespace, selector string) (map[string]PodMetrics, error) {
+	clientset, err := metricsclient.NewForConfig(c.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics client: %w", err)
+	}
+
+	list, err := clientset.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		// A missing API group is a 404, an aggregated API whose backend is
+		// down a 503
+		if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			return nil, ErrMetricsUnavailable
+		}
+		return nil, fmt.Errorf("failed to get pod metrics: %w", err)
+	}
+
+	metrics := make(map[string]PodMetrics, len(list.Items))
+	for _, item := range list.Items {
+		usage := PodMetrics{Name: item.Name}
+		for _, container := range item.Containers {
+			usage.CPUMilli += container.Usage.Cpu().MilliValue()
+			usage.MemoryBytes += container.Usage.Memory().Value()
+		}
+		metrics[item.Name] = usage
+	}
+	return metrics, nil
+}
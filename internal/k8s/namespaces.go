@@ -0,0 +1,60 @@
+package k8s
+
+import (
+	"fmt"
+	"path"
+)
+
+// NamespaceFilter limits the namespaces services are listed from in all
+// namespaces mode, using glob patterns like "team-*"
+type NamespaceFilter struct {
+	Include []string // Only these namespaces, all if empty
+	Exclude []string // Never these, even if included
+}
+
+// Validate reports the first malformed pattern
+func (f NamespaceFilter) Validate() error {
+	for _, pattern := range append(append([]string{}, f.Include...), f.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid namespace pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Allows reports whether services of namespace should be listed
+func (f NamespaceFilter) Allows(namespace string) bool {
+	if len(f.Include) > 0 && !matchesAny(f.Include, namespace) {
+		return false
+	}
+	return !matchesAny(f.Exclude, namespace)
+}
+
+func matchesAny(patterns []string, namespace string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetNamespaceFilter limits the namespaces listed when the client isn't
+// scoped to a single namespace
+func (c *Client) SetNamespaceFilter(filter NamespaceFilter) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.namespaceFilter = filter
+	c.mu.Unlock()
+	c.cache.invalidate()
+	return nil
+}
+
+// NamespaceFilter returns the filter set with SetNamespaceFilter
+func (c *Client) NamespaceFilter() NamespaceFilter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.namespaceFilter
+}
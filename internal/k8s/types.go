@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"strings"
 	"time"
 	
 	corev1 "k8s.io/api/core/v1"
@@ -40,18 +41,42 @@ type ServiceInfo struct {
 	Service        *corev1.Service
 	IsForwarding   bool
 	ForwardingPort int
+
+	// Endpoint addresses backing the service, only set when EndpointsKnown
+	EndpointsKnown bool
+	EndpointCount  int
+	ReadyEndpoints int
 }
 
 type PortInfo struct {
 	Name              string
 	Port              int32
 	TargetPort        int32
+	TargetPortName    string // Set when the service targets a named container port
+	NodePort          int32  // Port opened on every node for NodePort and LoadBalancer services
 	Protocol          string
 	ForwardingState   ForwardingState
 	ForwardingPort    int
 	ForwardStartTime  time.Time
+	ForwardPod        string // Pod an active forward goes to
+	ForwardTargetPort int    // Container port an active forward goes to
+	ForwardContainer  string // Container declaring that port, if known
 	FailureReason     string
 	FailureTime       time.Time
+	BytesIn           int64
+	BytesOut          int64
+}
+
+// Forwardable reports whether the port can be port-forwarded. The SPDY port
+// forward protocol only tunnels TCP, so UDP and SCTP ports can't be.
+func (p PortInfo) Forwardable() bool {
+	return IsForwardableProtocol(p.Protocol)
+}
+
+// IsForwardableProtocol reports whether ports of the given protocol can be
+// port-forwarded. An empty protocol means TCP.
+func IsForwardableProtocol(protocol string) bool {
+	return protocol == "" || strings.EqualFold(protocol, "TCP")
 }
 
 type DeploymentInfo struct {
@@ -61,4 +86,37 @@ type DeploymentInfo struct {
 	UpdatedReplicas int32
 	Image           string
 	CreatedAt       time.Time
+
+	// BarePods is set when the service selects pods no controller owns; the
+	// replica counts then describe those pods
+	BarePods bool
+	Restarts int32 // Container restarts across the bare pods
+}
+
+// PodInfo summarizes the health of a pod backing a service
+type PodInfo struct {
+	Name      string
+	Phase     string
+	Ready     bool
+	Restarts  int32
+	CreatedAt time.Time
+
+	Containers []ContainerInfo
+}
+
+// ContainerInfo lists the named ports a container of a pod declares
+type ContainerInfo struct {
+	Name       string
+	NamedPorts map[string]int32
+}
+
+// NamedPort returns the number of the container port called name, from the
+// first container declaring it like Kubernetes resolves target ports
+func (p PodInfo) NamedPort(name string) (int32, bool) {
+	for _, container := range p.Containers {
+		if port, ok := container.NamedPorts[name]; ok {
+			return port, true
+		}
+	}
+	return 0, false
 }
@@ -0,0 +1,114 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// watchDebounce coalesces bursts of watch events into a single update
+const watchDebounce = 250 * time.Millisecond
+
+// WatchServices streams the full service list every time a service is added,
+// updated, or deleted in the client's namespace (or all namespaces). The
+// channel is closed when ctx is cancelled.
+func (c *Client) WatchServices(ctx context.Context) (<-chan []ServiceInfo, error) {
+	// Selected from the cache rather than on the watch, which would apply
+	// to the endpoints informer too, and endpoints may lack the labels
+	selector, err := labels.Parse(c.LabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(c.GetClientset(), 0, informers.WithNamespace(c.namespace))
+	serviceInformer := factory.Core().V1().Services()
+	informer := serviceInformer.Informer()
+	lister := serviceInformer.Lister()
+	endpointsInformer := factory.Core().V1().Endpoints()
+	endpointsLister := endpointsInformer.Lister()
+
+	notify := make(chan struct{}, 1)
+	signal := func() {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { signal() },
+		UpdateFunc: func(interface{}, interface{}) { signal() },
+		DeleteFunc: func(interface{}) { signal() },
+	}
+	if _, err := informer.AddEventHandler(handler); err != nil {
+		return nil, fmt.Errorf("failed to register service watch: %w", err)
+	}
+	// Endpoint changes update the per-service endpoint counts
+	if _, err := endpointsInformer.Informer().AddEventHandler(handler); err != nil {
+		return nil, fmt.Errorf("failed to register endpoints watch: %w", err)
+	}
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, endpointsInformer.Informer().HasSynced) {
+		return nil, fmt.Errorf("failed to sync service watch")
+	}
+
+	updates := make(chan []ServiceInfo, 1)
+	go func() {
+		defer close(updates)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-notify:
+			}
+
+			// Let a burst of events settle before listing
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchDebounce):
+			}
+
+			svcs, err := lister.List(selector)
+			if err != nil {
+				continue
+			}
+
+			filter := c.NamespaceFilter()
+			services := make([]ServiceInfo, 0, len(svcs))
+			for _, svc := range svcs {
+				if c.namespace == "" && !filter.Allows(svc.Namespace) {
+					continue
+				}
+				info := newServiceInfo(svc.DeepCopy())
+				ep, err := endpointsLister.Endpoints(svc.Namespace).Get(svc.Name)
+				if err != nil {
+					ep = nil
+				}
+				setEndpointCounts(&info, ep)
+				services = append(services, info)
+			}
+			sort.Slice(services, func(i, j int) bool {
+				if services[i].Namespace != services[j].Namespace {
+					return services[i].Namespace < services[j].Namespace
+				}
+				return services[i].Name < services[j].Name
+			})
+
+			// Replace any update the consumer hasn't picked up yet
+			select {
+			case <-updates:
+			default:
+			}
+			updates <- services
+		}
+	}()
+
+	return updates, nil
+}
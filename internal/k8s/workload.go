@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// workloadFromPods finds the workload behind a service by listing the pods it
+// selects and following their controller owner references up to a
+// Deployment, StatefulSet, DaemonSet or ReplicaSet. Pods without a controller
+// are reported directly if none of them has one. It returns nil if the
+// selector matches no pods or their controllers are of another kind.
+func (c *Client) workloadFromPods(ctx context.Context, namespace string, selector map[string]string) (*DeploymentInfo, error) {
+	if len(selector) == 0 {
+		return nil, nil
+	}
+
+	pods, err := c.GetClientset().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+	if err != nil || len(pods.Items) == 0 {
+		return nil, err
+	}
+
+	controlled := false
+	for i := range pods.Items {
+		owner := metav1.GetControllerOf(&pods.Items[i])
+		if owner == nil {
+			continue
+		}
+		controlled = true
+		info, err := c.workloadFromOwner(ctx, namespace, owner)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			return info, nil
+		}
+	}
+
+	if controlled {
+		return nil, nil
+	}
+	return barePodsInfo(pods.Items), nil
+}
+
+// workloadFromOwner describes the workload a pod's controller reference
+// points at, going from a ReplicaSet to its Deployment when there is one
+func (c *Client) workloadFromOwner(ctx context.Context, namespace string, owner *metav1.OwnerReference) (*DeploymentInfo, error) {
+	apps := c.GetClientset().AppsV1()
+
+	switch owner.Kind {
+	case "ReplicaSet":
+		rs, err := apps.ReplicaSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if rsOwner := metav1.GetControllerOf(rs); rsOwner != nil && rsOwner.Kind == "Deployment" {
+			dep, err := apps.Deployments(namespace).Get(ctx, rsOwner.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return deploymentInfo(dep), nil
+		}
+		return replicaSetInfo(rs), nil
+
+	case "StatefulSet":
+		sts, err := apps.StatefulSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return statefulSetInfo(sts), nil
+
+	case "DaemonSet":
+		ds, err := apps.DaemonSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return daemonSetInfo(ds), nil
+	}
+
+	// Owned by something else (e.g. a Job), keep looking at the other pods
+	return nil, nil
+}
+
+func deploymentInfo(dep *appsv1.Deployment) *DeploymentInfo {
+	var replicas int32 = 0
+	if dep.Spec.Replicas != nil {
+		replicas = *dep.Spec.Replicas
+	}
+	return &DeploymentInfo{
+		Name:            dep.Name,
+		Replicas:        replicas,
+		ReadyReplicas:   dep.Status.ReadyReplicas,
+		UpdatedReplicas: dep.Status.UpdatedReplicas,
+		Image:           getMainContainerImage(dep),
+		CreatedAt:       dep.CreationTimestamp.Time,
+	}
+}
+
+func statefulSetInfo(sts *appsv1.StatefulSet) *DeploymentInfo {
+	var replicas int32 = 0
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	return &DeploymentInfo{
+		Name:            sts.Name + " (StatefulSet)",
+		Replicas:        replicas,
+		ReadyReplicas:   sts.Status.ReadyReplicas,
+		UpdatedReplicas: sts.Status.UpdatedReplicas,
+		Image:           getMainContainerImageFromStatefulSet(sts),
+		CreatedAt:       sts.CreationTimestamp.Time,
+	}
+}
+
+func daemonSetInfo(ds *appsv1.DaemonSet) *DeploymentInfo {
+	return &DeploymentInfo{
+		Name:            ds.Name + " (DaemonSet)",
+		Replicas:        ds.Status.DesiredNumberScheduled,
+		ReadyReplicas:   ds.Status.NumberReady,
+		UpdatedReplicas: ds.Status.UpdatedNumberScheduled,
+		Image:           getMainContainerImageFromDaemonSet(ds),
+		CreatedAt:       ds.CreationTimestamp.Time,
+	}
+}
+
+func replicaSetInfo(rs *appsv1.ReplicaSet) *DeploymentInfo {
+	var replicas int32 = 0
+	if rs.Spec.Replicas != nil {
+		replicas = *rs.Spec.Replicas
+	}
+	return &DeploymentInfo{
+		Name:            rs.Name + " (ReplicaSet)",
+		Replicas:        replicas,
+		ReadyReplicas:   rs.Status.ReadyReplicas,
+		UpdatedReplicas: rs.Status.Replicas,
+		Image:           getMainContainerImageFromPodSpec(&rs.Spec.Template.Spec),
+		CreatedAt:       rs.CreationTimestamp.Time,
+	}
+}
@@ -0,0 +1,94 @@
+package portforward
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// EventType names a forward lifecycle transition
+type EventType string
+
+const (
+	EventStarted      EventType = "started"
+	EventStopped      EventType = "stopped"
+	EventFailed       EventType = "failed"
+	EventReconnecting EventType = "reconnecting"
+	EventReconnected  EventType = "reconnected"
+)
+
+// Event describes a state transition of a forward
+type Event struct {
+	Time       time.Time `json:"time"`
+	Type       EventType `json:"event"`
+	Namespace  string    `json:"namespace"`
+	Service    string    `json:"service"`
+	RemotePort int       `json:"remotePort"`
+	LocalPort  int       `json:"localPort,omitempty"`
+	Pod        string    `json:"pod,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	Attempt    int       `json:"attempt,omitempty"`
+}
+
+// eventBuffer is how many events a subscriber may fall behind before further
+// events are dropped for it; the manager never blocks on a slow subscriber
+const eventBuffer = 64
+
+// Subscribe returns a channel receiving every forward event from now on, and
+// a function that ends the subscription and closes the channel
+func (m *Manager) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, eventBuffer)
+
+	m.eventsMu.Lock()
+	if m.subscribers == nil {
+		m.subscribers = make(map[chan Event]struct{})
+	}
+	m.subscribers[ch] = struct{}{}
+	m.eventsMu.Unlock()
+
+	unsubscribe := func() {
+		m.eventsMu.Lock()
+		defer m.eventsMu.Unlock()
+		if _, ok := m.subscribers[ch]; ok {
+			delete(m.subscribers, ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// emit sends an event about fw to all subscribers. The caller must hold m.mu
+// or otherwise keep fw from changing.
+func (m *Manager) emit(fw *ForwardInfo, eventType EventType, reason string) {
+	event := Event{
+		Time:       time.Now(),
+		Type:       eventType,
+		Namespace:  fw.Namespace,
+		Service:    fw.Service,
+		RemotePort: fw.RemotePort,
+		LocalPort:  fw.LocalPort,
+		Pod:        fw.PodName,
+		Reason:     reason,
+		Attempt:    fw.ReconnectAttempts,
+	}
+
+	m.eventsMu.Lock()
+	defer m.eventsMu.Unlock()
+	for ch := range m.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// WriteEvents writes events to w as JSON lines until the channel is closed
+func WriteEvents(w io.Writer, events <-chan Event) error {
+	encoder := json.NewEncoder(w)
+	for event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
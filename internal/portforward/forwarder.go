@@ -0,0 +1,84 @@
+package portforward
+
+import (
+	"context"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+)
+
+// Forwarder starts and stops port forwards and reports their state. Manager
+// implements it against a real cluster; the TUI, the API server and the event
+// log only depend on the interfaces in this file, so other backends can stand
+// in for it. The TUI uses the optional ones below when a Forwarder has them.
+type Forwarder interface {
+	StartForwardToContainer(ctx context.Context, namespace, serviceName, podName, container string, remotePort, preferredLocalPort int) (int, error)
+	RestartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error)
+	CancelPending(namespace, serviceName string, remotePort int) bool
+	StopForward(namespace, serviceName string, remotePort int)
+	StopAll()
+	GetForwardInfo(namespace, serviceName string, remotePort int) *ForwardInfo
+	GetAllForwards() map[string]*ForwardInfo
+}
+
+// EventSource publishes the lifecycle events of forwards
+type EventSource interface {
+	Subscribe() (<-chan Event, func())
+}
+
+// StateStore starts saved forwards and persists forwards across sessions
+type StateStore interface {
+	StartForwards(ctx context.Context, forwards []SavedForward) error
+	SavedForwards() []SavedForward
+	SaveState(path string) error
+	RestoreState(ctx context.Context, path string) error
+	SaveLocalPorts(path string, previous LocalPorts) error
+}
+
+// Reaper stops the forwards of services that no longer exist
+type Reaper interface {
+	ReapForwards(namespace string, exists func(namespace, serviceName string) bool) []string
+}
+
+// Backend is a Forwarder with all the optional interfaces, as the Manager
+// and the demo forwarder are
+type Backend interface {
+	Forwarder
+	EventSource
+	StateStore
+	Reaper
+}
+
+var _ Backend = (*Manager)(nil)
+
+// SummaryOf returns the number of active and failed forwards among forwards,
+// when the oldest active one started and their combined traffic
+func SummaryOf(forwards map[string]*ForwardInfo) Summary {
+	var summary Summary
+	for _, fw := range forwards {
+		if fw.ForwardingState == k8s.ForwardingStateFailed {
+			summary.Failed++
+		}
+		if fw.ForwardingState != k8s.ForwardingStateActive {
+			continue
+		}
+		summary.Active++
+		if summary.OldestStart.IsZero() || fw.StartedAt.Before(summary.OldestStart) {
+			summary.OldestStart = fw.StartedAt
+		}
+		summary.BytesIn += fw.BytesIn
+		summary.BytesOut += fw.BytesOut
+	}
+	return summary
+}
+
+// AllocatedPortsOf returns the local ports assigned to forwards, including
+// pending ones, mapped to the namespace/service:port they serve
+func AllocatedPortsOf(forwards map[string]*ForwardInfo) map[int]string {
+	ports := make(map[int]string)
+	for key, fw := range forwards {
+		if fw.LocalPort > 0 && fw.ForwardingState != k8s.ForwardingStateFailed {
+			ports[fw.LocalPort] = key
+		}
+	}
+	return ports
+}
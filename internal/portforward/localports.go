@@ -0,0 +1,97 @@
+package portforward
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+)
+
+// LocalPorts maps "namespace/service:port" to the preferred local port
+type LocalPorts map[string]int
+
+// LocalPortKey returns the LocalPorts key of a service port
+func LocalPortKey(namespace, service string, port int) string {
+	return fmt.Sprintf("%s/%s:%d", namespace, service, port)
+}
+
+// DefaultLocalPortsPath returns the file the last used local ports are kept in
+func DefaultLocalPortsPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "kpf", "local-ports.json")
+}
+
+// ParseLocalPortMapping parses "namespace/service:port=localPort"
+func ParseLocalPortMapping(spec string) (string, int, error) {
+	invalid := fmt.Errorf("invalid local port mapping %q, expected namespace/service:port=localPort", spec)
+
+	target, local, ok := strings.Cut(spec, "=")
+	if !ok {
+		return "", 0, invalid
+	}
+	sf, err := ParseForwardSpec(target)
+	if err != nil || sf.LocalPort != 0 {
+		return "", 0, invalid
+	}
+	localPort, err := strconv.Atoi(local)
+	if err != nil || localPort < 1 || localPort > 65535 {
+		return "", 0, invalid
+	}
+	return LocalPortKey(sf.Namespace, sf.Service, sf.RemotePort), localPort, nil
+}
+
+// LoadLocalPorts reads the local ports remembered at path. A missing file is not an error.
+func LoadLocalPorts(path string) (LocalPorts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return LocalPorts{}, nil
+		}
+		return nil, fmt.Errorf("failed to read local ports: %w", err)
+	}
+
+	ports := LocalPorts{}
+	if err := json.Unmarshal(data, &ports); err != nil {
+		return nil, fmt.Errorf("failed to decode local ports: %w", err)
+	}
+	return ports, nil
+}
+
+// SaveLocalPorts merges the local ports of the active forwards into previous
+// and writes the result to path
+func (m *Manager) SaveLocalPorts(path string, previous LocalPorts) error {
+	ports := LocalPorts{}
+	for k, v := range previous {
+		ports[k] = v
+	}
+
+	m.mu.RLock()
+	for _, fw := range m.forwards {
+		if fw.ForwardingState == k8s.ForwardingStateActive && fw.LocalPort > 0 {
+			ports[LocalPortKey(fw.Namespace, fw.Service, fw.RemotePort)] = fw.LocalPort
+		}
+	}
+	m.mu.RUnlock()
+
+	// encoding/json sorts map keys, keeping the file stable between saves
+	data, err := json.MarshalIndent(ports, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode local ports: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write local ports: %w", err)
+	}
+	return nil
+}
@@ -2,25 +2,45 @@ package portforward
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/grumpylabs/kpf/internal/k8s"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
 )
 
+// ErrAlreadyForwarding is returned when a forward for the same service port
+// already exists, whether it is still pending or active
+var ErrAlreadyForwarding = errors.New("port forwarding already active")
+
+// ErrNoPod is returned when a service has no pod a forward could go to, e.g.
+// a selectorless service whose endpoints point outside the cluster. Such
+// services can still be forwarded through a pod named with StartForwardToPod.
+var ErrNoPod = errors.New("no pod to forward to")
+
+// ErrForwardCancelled is returned when a pending forward is cancelled with
+// CancelPending before it became ready
+var ErrForwardCancelled = errors.New("port forward cancelled")
+
 type ForwardInfo struct {
 	Namespace       string
 	Service         string
 	RemotePort      int
+	TargetPort      int // Container port the service port resolves to
 	LocalPort       int
 	StopChan        chan struct{}
 	ReadyChan       chan struct{}
@@ -28,19 +48,168 @@ type ForwardInfo struct {
 	ForwardingState k8s.ForwardingState
 	FailureReason   string
 	FailureTime     time.Time
+	BindAddress     string
+	PodName         string // Pod the tunnel currently goes to
+	Container       string // Container declaring the target port, if known
+
+	// ReconnectAttempts counts reconnects since the tunnel last dropped
+	ReconnectAttempts int
+
+	// Traffic through the forward, filled in on the copies returned by getters
+	BytesIn  int64
+	BytesOut int64
+	traffic  *trafficCounter
+
+	// pinnedPod is set when the user chose the pod to forward to
+	pinnedPod string
+
+	// cancel aborts the start of a pending forward
+	cancel context.CancelCauseFunc
+}
+
+// isFor reports whether fw forwards a port of the given service. Comparing the
+// fields rather than key prefixes can't confuse services whose names share a
+// prefix.
+func (fw *ForwardInfo) isFor(namespace, serviceName string) bool {
+	return fw.Namespace == namespace && fw.Service == serviceName
+}
+
+// snapshot returns a copy of fw with the traffic counters filled in
+func (fw *ForwardInfo) snapshot() ForwardInfo {
+	copy := *fw
+	if fw.traffic != nil {
+		copy.BytesIn = fw.traffic.bytesIn.Load()
+		copy.BytesOut = fw.traffic.bytesOut.Load()
+	}
+	return copy
+}
+
+// RetryPolicy controls how dropped port forwards are re-established
+type RetryPolicy struct {
+	MaxAttempts    int           // Reconnect attempts before giving up (0 disables reconnects)
+	InitialBackoff time.Duration // Delay before the first reconnect attempt
+	MaxBackoff     time.Duration // Upper bound for the exponential backoff
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewManager
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// DefaultReadyTimeout is how long a new forward may take to become ready
+const DefaultReadyTimeout = 10 * time.Second
+
+// DefaultBindAddress is the local address forwards listen on
+const DefaultBindAddress = "localhost"
+
+// ConflictPolicy decides what happens when the preferred local port of a
+// forward is already taken
+type ConflictPolicy string
+
+const (
+	// ConflictPrompt fails the forward so the caller can ask for another port
+	ConflictPrompt ConflictPolicy = "prompt"
+
+	// ConflictAuto binds the next free port after the preferred one instead
+	ConflictAuto ConflictPolicy = "auto"
+)
+
+// maxConflictProbe is how many ports after a taken one ConflictAuto tries
+// before falling back to any free port
+const maxConflictProbe = 100
+
+// ParseConflictPolicy parses an --on-conflict value
+func ParseConflictPolicy(value string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(value); policy {
+	case ConflictPrompt, ConflictAuto:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid conflict policy %q, expected %q or %q", value, ConflictPrompt, ConflictAuto)
+	}
 }
 
 type Manager struct {
-	client   *k8s.Client
-	forwards map[string]*ForwardInfo
-	mu       sync.RWMutex
+	client      *k8s.Client
+	forwards    map[string]*ForwardInfo
+	retryPolicy RetryPolicy
+	mu          sync.RWMutex
+
+	// Channels of Subscribe, guarded by their own mutex so events can be
+	// emitted while mu is held
+	subscribers map[chan Event]struct{}
+	eventsMu    sync.Mutex
+
+	// ReadyTimeout bounds how long StartForwardWithLocalPort waits for a
+	// forward to become ready. Set it before starting any forwards.
+	ReadyTimeout time.Duration
+
+	// BindAddress is the local address forwards listen on. Check it with
+	// ResolveBindAddress before setting it.
+	BindAddress string
+
+	// OnConflict decides what happens when a preferred local port is taken.
+	// Set it before starting any forwards.
+	OnConflict ConflictPolicy
+
+	// VerifyForward probes a forward through its local port once it is ready
+	// and fails it if the pod doesn't accept connections
+	VerifyForward bool
 }
 
 func NewManager(client *k8s.Client) *Manager {
 	return &Manager{
-		client:   client,
-		forwards: make(map[string]*ForwardInfo),
+		client:       client,
+		forwards:     make(map[string]*ForwardInfo),
+		retryPolicy:  DefaultRetryPolicy(),
+		ReadyTimeout: DefaultReadyTimeout,
+		BindAddress:  DefaultBindAddress,
+		OnConflict:   ConflictPrompt,
+	}
+}
+
+// ValidateBindAddress checks that addr is "localhost" or a literal IP address
+func ValidateBindAddress(addr string) error {
+	if addr == "localhost" || net.ParseIP(addr) != nil {
+		return nil
+	}
+	return fmt.Errorf("invalid bind address %q: must be localhost or an IP address", addr)
+}
+
+// ResolveBindAddress returns the address forwards to addr should listen on. A
+// non-loopback address that cannot be bound (e.g. not assigned to this host or
+// refused by policy) falls back to localhost, returned along with the reason.
+func ResolveBindAddress(addr string) (string, error) {
+	if addr == "" || addr == DefaultBindAddress {
+		return DefaultBindAddress, nil
+	}
+	if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
+		return addr, nil
 	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(addr, "0"))
+	if err != nil {
+		return DefaultBindAddress, fmt.Errorf("cannot bind %s, forwarding on %s instead: %w", addr, DefaultBindAddress, err)
+	}
+	l.Close()
+	return addr, nil
+}
+
+// SetRetryPolicy replaces the policy used to reconnect dropped forwards
+func (m *Manager) SetRetryPolicy(policy RetryPolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = time.Second
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		policy.MaxBackoff = policy.InitialBackoff
+	}
+	m.retryPolicy = policy
 }
 
 func (m *Manager) StartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error) {
@@ -48,15 +217,31 @@ func (m *Manager) StartForward(ctx context.Context, namespace, serviceName strin
 }
 
 func (m *Manager) StartForwardWithLocalPort(ctx context.Context, namespace, serviceName string, remotePort, preferredLocalPort int) (int, error) {
+	return m.StartForwardToPod(ctx, namespace, serviceName, "", remotePort, preferredLocalPort)
+}
+
+// StartForwardToPod forwards a service port through a specific pod instead of
+// the first pod the service selects. An empty podName picks a pod automatically.
+// Reconnects stay pinned to the chosen pod.
+func (m *Manager) StartForwardToPod(ctx context.Context, namespace, serviceName, podName string, remotePort, preferredLocalPort int) (int, error) {
+	return m.StartForwardToContainer(ctx, namespace, serviceName, podName, "", remotePort, preferredLocalPort)
+}
+
+// StartForwardToContainer is StartForwardToPod for pods whose containers
+// declare the service's named target port differently: the port of the given
+// container is used. An empty container uses the first one declaring it.
+func (m *Manager) StartForwardToContainer(ctx context.Context, namespace, serviceName, podName, container string, remotePort, preferredLocalPort int) (localPort int, err error) {
 	key := fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
-	
-	// Check if already exists with minimal lock time
-	m.mu.Lock()
-	if _, exists := m.forwards[key]; exists {
-		m.mu.Unlock()
-		return 0, fmt.Errorf("port forwarding already active for %s", key)
-	}
-	m.mu.Unlock()
+
+	// CancelPending cancels this context; whatever step was in flight then
+	// fails, and the caller gets ErrForwardCancelled instead of that error
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	defer func() {
+		if err != nil && errors.Is(context.Cause(ctx), ErrForwardCancelled) {
+			localPort, err = 0, ErrForwardCancelled
+		}
+	}()
 
 	// Create a basic ForwardInfo entry to track any failures that occur early
 	fw := &ForwardInfo{
@@ -66,288 +251,586 @@ func (m *Manager) StartForwardWithLocalPort(ctx context.Context, namespace, serv
 		LocalPort:       0, // Will be set if we get a port
 		StartedAt:       time.Now(),
 		ForwardingState: k8s.ForwardingStatePending,
+		traffic:         &trafficCounter{},
+		pinnedPod:       podName,
+		cancel:          cancel,
+	}
+
+	// Reserve the key as pending so concurrent starts and UI refreshes see it
+	m.mu.Lock()
+	if _, exists := m.forwards[key]; exists {
+		m.mu.Unlock()
+		return 0, fmt.Errorf("%w for %s", ErrAlreadyForwarding, key)
 	}
+	m.forwards[key] = fw
+	m.mu.Unlock()
 
-	// Do the Kubernetes API calls without holding the mutex
-	pods, err := m.client.GetClientset().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("app=%s", serviceName),
-	})
+	// Do the Kubernetes API calls without holding the mutex.
+	// The SPDY port forward protocol only tunnels TCP, so refuse UDP and SCTP
+	// up front instead of silently forwarding TCP to a port nothing listens on.
+	servicePort, err := m.client.GetServicePort(ctx, namespace, serviceName, remotePort)
+	if err == nil && !k8s.IsForwardableProtocol(string(servicePort.Protocol)) {
+		m.markFailed(fw, fmt.Sprintf("%s ports cannot be forwarded, Kubernetes port forwarding only supports TCP", servicePort.Protocol))
+		return 0, fmt.Errorf("%s port %d cannot be forwarded, Kubernetes port forwarding only supports TCP", servicePort.Protocol, remotePort)
+	}
+
+	if podName == "" {
+		podName, err = m.findPodWithRetry(ctx, namespace, serviceName)
+		if err != nil {
+			m.markFailed(fw, failureReason(err))
+			return 0, err
+		}
+	}
+
+	// Forward to the container port the service port targets, which may be
+	// a different number or a named port
+	targetPort, container, err := m.resolveTargetPort(ctx, namespace, podName, container, servicePort, remotePort)
 	if err != nil {
-		fw.ForwardingState = k8s.ForwardingStateFailed
-		fw.FailureReason = fmt.Sprintf("Failed to list pods: %v", err)
-		fw.FailureTime = time.Now()
-		
-		// Store the failed forward info with lock
+		m.markFailed(fw, failureReason(err))
+		return 0, err
+	}
+
+	bindAddress := m.bindAddress()
+
+	localPort, err = m.allocateLocalPort(fw, bindAddress, preferredLocalPort)
+	if err != nil {
+		m.markFailed(fw, failureReason(err))
+		return 0, err
+	}
+
+	dialer, err := m.newDialer(fw, podName)
+	if err != nil {
+		m.markFailed(fw, fmt.Sprintf("Failed to create round tripper: %v", err))
+		return 0, err
+	}
+
+	stopChan := make(chan struct{}, 1)
+	readyChan := make(chan struct{})
+	doneChan := make(chan struct{})
+
+	// Update the existing fw instance
+	m.mu.Lock()
+	fw.TargetPort = targetPort
+	fw.Container = container
+	fw.BindAddress = bindAddress
+	fw.StopChan = stopChan
+	fw.ReadyChan = readyChan
+	m.mu.Unlock()
+
+	go m.runForward(fw, dialer, readyChan, doneChan)
+
+	select {
+	case <-readyChan:
+		if m.VerifyForward {
+			if err := verifyForward(ctx, bindAddress, localPort); err != nil {
+				m.markFailed(fw, failureReason(err))
+				closeStopChan(stopChan)
+				return 0, err
+			}
+			// The probes aren't the user's traffic
+			if fw.traffic != nil {
+				fw.traffic.reset()
+			}
+		}
+
+		// Mark as ready, unless the forward was cancelled or stopped meanwhile
 		m.mu.Lock()
-		m.forwards[key] = fw
+		if m.forwards[key] != fw {
+			m.mu.Unlock()
+			closeStopChan(stopChan)
+			return 0, ErrForwardCancelled
+		}
+		fw.ForwardingState = k8s.ForwardingStateActive
+		m.emit(fw, EventStarted, "")
 		m.mu.Unlock()
-		return 0, fmt.Errorf("failed to list pods: %w", err)
+		return localPort, nil
+	case <-doneChan:
+		// The forwarder gave up before it ever became ready
+		m.mu.RLock()
+		reason := fw.FailureReason
+		m.mu.RUnlock()
+		return 0, fmt.Errorf("%s", reason)
+	case <-ctx.Done():
+		closeStopChan(stopChan)
+		// CancelPending already forgot the forward and reported it stopped
+		if errors.Is(context.Cause(ctx), ErrForwardCancelled) {
+			return 0, ErrForwardCancelled
+		}
+		m.markFailed(fw, "Context cancelled")
+		return 0, fmt.Errorf("context cancelled")
+	case <-time.After(m.StartTimeout()):
+		m.markFailed(fw, "Timeout waiting for port forward to be ready")
+		closeStopChan(stopChan)
+		return 0, fmt.Errorf("timeout waiting for port forward to be ready")
 	}
+}
+
+// StartTimeout returns how long starting a forward waits for it to become
+// ready: ReadyTimeout, or the default if unset
+func (m *Manager) StartTimeout() time.Duration {
+	if m.ReadyTimeout <= 0 {
+		return DefaultReadyTimeout
+	}
+	return m.ReadyTimeout
+}
+
+// bindAddress returns the address new forwards should listen on
+func (m *Manager) bindAddress() string {
+	if m.BindAddress == "" {
+		return DefaultBindAddress
+	}
+	return m.BindAddress
+}
+
+// runForward keeps the tunnel for fw open until its StopChan is closed. When
+// an established tunnel drops, it re-resolves a pod and reconnects on the same
+// local port according to the manager's retry policy.
+func (m *Manager) runForward(fw *ForwardInfo, dialer httpstream.Dialer, readyChan chan struct{}, doneChan chan struct{}) {
+	defer close(doneChan)
+
+	m.mu.RLock()
+	policy := m.retryPolicy
+	m.mu.RUnlock()
+
+	ports := []string{fmt.Sprintf("%d:%d", fw.LocalPort, fw.TargetPort)}
+	attempt := 0
+	backoff := policy.InitialBackoff
+	established := false
+
+	for {
+		err := m.forwardOnce(fw, dialer, ports, readyChan)
+
+		// The tunnel that just ended was listening, so it either was the
+		// first one or reconnected: a new drop starts counting afresh
+		if isClosed(readyChan) {
+			established = true
+			attempt = 0
+			backoff = policy.InitialBackoff
+		}
+
+		select {
+		case <-fw.StopChan:
+			// Normal stop requested
+			return
+		default:
+		}
+
+		if err == nil {
+			err = portforward.ErrLostConnectionToPod
+		}
+		m.markFailed(fw, fmt.Sprintf("Port forwarding failed: %v", err))
+
+		// Only reconnect tunnels that were established at least once; initial
+		// failures are reported back to the caller of StartForwardWithLocalPort.
+		// A re-dial that fails before becoming ready is retried as well.
+		if !established || attempt >= policy.MaxAttempts {
+			return
+		}
+
+		// Keep trying until a pod can be resolved or the attempts run out
+		for {
+			attempt++
+			select {
+			case <-fw.StopChan:
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > policy.MaxBackoff {
+				backoff = policy.MaxBackoff
+			}
 
-	if len(pods.Items) == 0 {
-		endpoints, err := m.client.GetClientset().CoreV1().Endpoints(namespace).Get(ctx, serviceName, metav1.GetOptions{})
-		if err != nil {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Failed to get endpoints: %v", err)
-			fw.FailureTime = time.Now()
-			
 			m.mu.Lock()
-			m.forwards[key] = fw
+			fw.ForwardingState = k8s.ForwardingStatePending
+			fw.ReconnectAttempts = attempt
+			m.emit(fw, EventReconnecting, "")
 			m.mu.Unlock()
-			return 0, fmt.Errorf("failed to get endpoints: %w", err)
+
+			dialer, err = m.redial(fw)
+			if err == nil {
+				break
+			}
+			m.markFailed(fw, fmt.Sprintf("Reconnect attempt %d failed: %v", attempt, err))
+			if attempt >= policy.MaxAttempts {
+				return
+			}
+		}
+
+		readyChan = make(chan struct{})
+		m.mu.Lock()
+		fw.ReadyChan = readyChan
+		m.mu.Unlock()
+
+		// Mark the forward active again once the new tunnel is listening
+		go func(readyChan chan struct{}) {
+			select {
+			case <-readyChan:
+				m.mu.Lock()
+				fw.ForwardingState = k8s.ForwardingStateActive
+				fw.FailureReason = ""
+				fw.FailureTime = time.Time{}
+				m.emit(fw, EventReconnected, "")
+				m.mu.Unlock()
+			case <-fw.StopChan:
+			}
+		}(readyChan)
+	}
+}
+
+// forwardOnce runs a single port forwarder until it stops or loses its connection
+func (m *Manager) forwardOnce(fw *ForwardInfo, dialer httpstream.Dialer, ports []string, readyChan chan struct{}) (err error) {
+	defer func() {
+		// Ensure we clean up if something goes wrong
+		if r := recover(); r != nil {
+			err = fmt.Errorf("port forwarder panicked: %v", r)
+		}
+	}()
+
+	// Use a buffer to capture any critical errors while discarding normal output
+	out, errOut := io.Discard, io.Discard
+	pf, err := portforward.NewOnAddresses(dialer, listenAddresses(fw.BindAddress), ports, fw.StopChan, readyChan, out, errOut)
+	if err != nil {
+		return fmt.Errorf("failed to create port forwarder: %w", err)
+	}
+
+	return pf.ForwardPorts()
+}
+
+// redial resolves a fresh pod (or the pinned pod) for fw and builds a new dialer for it
+func (m *Manager) redial(fw *ForwardInfo) (httpstream.Dialer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.StartTimeout())
+	defer cancel()
+
+	podName := fw.pinnedPod
+	if podName == "" {
+		var err error
+		podName, err = m.findPodForService(ctx, fw.Namespace, fw.Service)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m.newDialer(fw, podName)
+}
+
+// Pod discovery retries transient API errors this often, doubling the
+// backoff after each attempt
+const (
+	discoveryAttempts = 3
+	discoveryBackoff  = 250 * time.Millisecond
+)
+
+// findPodWithRetry is findPodForService retrying transient API server errors
+// (5xx, timeouts, throttling) with exponential backoff. Other errors such as
+// NotFound fail right away.
+func (m *Manager) findPodWithRetry(ctx context.Context, namespace, serviceName string) (string, error) {
+	backoff := discoveryBackoff
+	for attempt := 1; ; attempt++ {
+		podName, err := m.findPodForService(ctx, namespace, serviceName)
+		if err == nil || attempt == discoveryAttempts || !isTransientAPIError(err) {
+			return podName, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientAPIError reports whether err is an API server error that is
+// likely to go away on its own
+func isTransientAPIError(err error) bool {
+	return apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) ||
+		apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) || apierrors.IsUnexpectedServerError(err)
+}
+
+// findPodForService returns the name of a pod backing the given service
+func (m *Manager) findPodForService(ctx context.Context, namespace, serviceName string) (string, error) {
+	selector, err := m.client.GetServiceSelector(ctx, namespace, serviceName)
+	if forbidden := asForbidden(err, "get", "services", namespace); forbidden != nil {
+		return "", forbidden
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get service: %w", err)
+	}
+
+	// Selectorless services have no pods to list, go straight to the endpoints
+	pods := &corev1.PodList{}
+	if selector != "" {
+		pods, err = m.client.GetClientset().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if forbidden := asForbidden(err, "list", "pods", namespace); forbidden != nil {
+			return "", forbidden
+		} else if err != nil {
+			return "", fmt.Errorf("failed to list pods: %w", err)
+		}
+	}
+
+	if len(pods.Items) == 0 {
+		endpoints, err := m.client.GetClientset().CoreV1().Endpoints(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+		if forbidden := asForbidden(err, "get", "endpoints", namespace); forbidden != nil {
+			return "", forbidden
+		} else if err != nil {
+			return "", fmt.Errorf("failed to get endpoints: %w", err)
 		}
 
 		if len(endpoints.Subsets) == 0 || len(endpoints.Subsets[0].Addresses) == 0 {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("No pods found for service %s", serviceName)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("no pods found for service %s", serviceName)
+			if selector == "" {
+				return "", fmt.Errorf("%w: service %s has no selector and no endpoints", ErrNoPod, serviceName)
+			}
+			return "", fmt.Errorf("%w: no pods match the selector of service %s", ErrNoPod, serviceName)
 		}
 
 		address := endpoints.Subsets[0].Addresses[0]
-		if address.TargetRef == nil {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("No target reference found for service %s", serviceName)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("no target reference found for service %s", serviceName)
+		if address.TargetRef == nil || address.TargetRef.Kind != "Pod" || address.TargetRef.Name == "" {
+			// Manually managed endpoints, e.g. a database outside the cluster
+			return "", fmt.Errorf("%w: the endpoints of service %s point at %s, not at a pod", ErrNoPod, serviceName, address.IP)
 		}
-		
 		podName := address.TargetRef.Name
-		if podName == "" {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Empty pod name for service %s", serviceName)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("empty pod name for service %s", serviceName)
-		}
-		
+
 		pod, err := m.client.GetClientset().CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
-		if err != nil {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Failed to get pod: %v", err)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("failed to get pod: %w", err)
+		if forbidden := asForbidden(err, "get", "pods", namespace); forbidden != nil {
+			return "", forbidden
+		} else if err != nil {
+			return "", fmt.Errorf("failed to get pod: %w", err)
 		}
 		pods.Items = []corev1.Pod{*pod}
 	}
 
 	if len(pods.Items) == 0 {
-		fw.ForwardingState = k8s.ForwardingStateFailed
-		fw.FailureReason = fmt.Sprintf("No pods available for service %s", serviceName)
-		fw.FailureTime = time.Now()
-		
-		m.mu.Lock()
-		m.forwards[key] = fw
-		m.mu.Unlock()
-		return 0, fmt.Errorf("no pods available for service %s", serviceName)
+		return "", fmt.Errorf("%w: no pods available for service %s", ErrNoPod, serviceName)
 	}
-	
+
 	podName := pods.Items[0].Name
 	if podName == "" {
-		fw.ForwardingState = k8s.ForwardingStateFailed
-		fw.FailureReason = fmt.Sprintf("Pod name is empty for service %s", serviceName)
-		fw.FailureTime = time.Now()
-		
-		m.mu.Lock()
-		m.forwards[key] = fw
-		m.mu.Unlock()
-		return 0, fmt.Errorf("pod name is empty for service %s", serviceName)
+		return "", fmt.Errorf("pod name is empty for service %s", serviceName)
 	}
+	return podName, nil
+}
 
-	var localPort int
-	if preferredLocalPort > 0 {
-		// Check if the preferred port is available
-		if isPortAvailable(preferredLocalPort) {
-			localPort = preferredLocalPort
-		} else {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Port %d is already in use", preferredLocalPort)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("port %d is already in use", preferredLocalPort)
+// resolveTargetPort returns the container port of podName that servicePort
+// targets, and the container declaring it if it was looked up. Numeric target
+// ports are used as is, named ones are looked up in the pod's container ports,
+// only in the given container if one is set. Without a service port spec the
+// remote port is used.
+func (m *Manager) resolveTargetPort(ctx context.Context, namespace, podName, container string, servicePort *corev1.ServicePort, remotePort int) (int, string, error) {
+	if servicePort == nil {
+		return remotePort, container, nil
+	}
+
+	switch {
+	case servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal != 0:
+		return int(servicePort.TargetPort.IntVal), container, nil
+	case servicePort.TargetPort.Type == intstr.String && servicePort.TargetPort.StrVal != "":
+		name := servicePort.TargetPort.StrVal
+		pod, err := m.client.GetClientset().CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+		if forbidden := asForbidden(err, "get", "pods", namespace); forbidden != nil {
+			return 0, "", forbidden
+		} else if err != nil {
+			return 0, "", fmt.Errorf("failed to get pod: %w", err)
 		}
-	} else {
-		// Get any available port
-		localPort, err = getFreePort()
-		if err != nil {
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Failed to get free port: %v", err)
-			fw.FailureTime = time.Now()
-			
-			m.mu.Lock()
-			m.forwards[key] = fw
-			m.mu.Unlock()
-			return 0, fmt.Errorf("failed to get free port: %w", err)
+		for _, c := range pod.Spec.Containers {
+			if container != "" && c.Name != container {
+				continue
+			}
+			for _, port := range c.Ports {
+				if port.Name == name {
+					return int(port.ContainerPort), c.Name, nil
+				}
+			}
+		}
+		if container != "" {
+			return 0, "", fmt.Errorf("named target port %q not found in container %s of pod %s", name, container, podName)
 		}
+		return 0, "", fmt.Errorf("named target port %q not found in pod %s", name, podName)
+	default:
+		// An unset target port defaults to the service port
+		return remotePort, container, nil
 	}
+}
+
+// newDialer builds an SPDY dialer for the portforward subresource of a pod,
+// counting the traffic of fw's data streams
+func (m *Manager) newDialer(fw *ForwardInfo, podName string) (httpstream.Dialer, error) {
+	m.mu.Lock()
+	fw.PodName = podName
+	m.mu.Unlock()
 
 	req := m.client.GetClientset().CoreV1().RESTClient().
 		Post().
 		Resource("pods").
-		Namespace(namespace).
+		Namespace(fw.Namespace).
 		Name(podName).
 		SubResource("portforward")
 
 	transport, upgrader, err := spdy.RoundTripperFor(m.client.GetConfig())
 	if err != nil {
-		return 0, fmt.Errorf("failed to create round tripper: %w", err)
+		return nil, fmt.Errorf("failed to create round tripper: %w", err)
 	}
 
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+	return &countingDialer{Dialer: &rbacDialer{Dialer: dialer, namespace: fw.Namespace}, counter: fw.traffic}, nil
+}
 
-	stopChan := make(chan struct{}, 1)
-	readyChan := make(chan struct{})
+// markFailed records a failure reason on fw. Forwards that were cancelled or
+// stopped meanwhile are no longer tracked and are left alone, so no failure
+// is reported after they were reported stopped.
+func (m *Manager) markFailed(fw *ForwardInfo, reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	// Update the existing fw instance
-	fw.LocalPort = localPort
-	fw.StopChan = stopChan
-	fw.ReadyChan = readyChan
+	key := fmt.Sprintf("%s/%s:%d", fw.Namespace, fw.Service, fw.RemotePort)
+	if m.forwards[key] != fw {
+		return
+	}
 
-	ports := []string{fmt.Sprintf("%d:%d", localPort, remotePort)}
-
-	go func() {
-		defer func() {
-			// Ensure we clean up if something goes wrong
-			if r := recover(); r != nil {
-				// Panic occurred, ensure channels are closed
-				select {
-				case <-readyChan:
-					// Already closed
-				default:
-					close(readyChan)
-				}
-			}
-		}()
-		
-		// Use a buffer to capture any critical errors while discarding normal output
-		out, errOut := io.Discard, io.Discard
-		pf, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, ports, stopChan, readyChan, out, errOut)
-		if err != nil {
-			// Critical error - mark as failed and close readyChan to signal failure
-			m.mu.Lock()
-			fw.ForwardingState = k8s.ForwardingStateFailed
-			fw.FailureReason = fmt.Sprintf("Failed to create port forwarder: %v", err)
-			fw.FailureTime = time.Now()
-			m.mu.Unlock()
-			
-			select {
-			case <-readyChan:
-				// Already closed
-			default:
-				close(readyChan)
-			}
-			return
+	fw.ForwardingState = k8s.ForwardingStateFailed
+	fw.FailureReason = reason
+	fw.FailureTime = time.Now()
+	m.emit(fw, EventFailed, reason)
+}
+
+func (m *Manager) StopForward(namespace, serviceName string, remotePort int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
+	if fw, exists := m.forwards[key]; exists {
+		// Safely close the channel if it exists and is not already closed
+		if fw.StopChan != nil {
+			closeStopChan(fw.StopChan)
 		}
-		
-		// Run port forwarding in a separate goroutine with monitoring
-		errChan := make(chan error, 1)
-		go func() {
-			errChan <- pf.ForwardPorts()
-		}()
-		
-		// Monitor for errors or stop signal
+		delete(m.forwards, key)
+		m.emit(fw, EventStopped, "")
+	}
+}
+
+// restartReleaseTimeout bounds how long RestartForward waits for the stopped
+// forward to release its local port
+const restartReleaseTimeout = 2 * time.Second
+
+// RestartForward stops a forward and starts it again on the same local port.
+// The pod is looked up afresh, so the forward lands on a running replica even
+// if the one it went to was replaced.
+func (m *Manager) RestartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error) {
+	fw := m.GetForwardInfo(namespace, serviceName, remotePort)
+	if fw == nil {
+		return 0, fmt.Errorf("%s/%s:%d is not being forwarded", namespace, serviceName, remotePort)
+	}
+	localPort, bindAddress := fw.LocalPort, fw.BindAddress
+	if bindAddress == "" {
+		bindAddress = m.bindAddress()
+	}
+
+	m.StopForward(namespace, serviceName, remotePort)
+
+	// The listener closes in the background once the forward is stopped
+	deadline := time.Now().Add(restartReleaseTimeout)
+	for localPort > 0 && !IsPortAvailable(bindAddress, localPort) && time.Now().Before(deadline) {
 		select {
-		case err := <-errChan:
-			if err != nil {
-				// Port forwarding failed
-				m.mu.Lock()
-				if fw, exists := m.forwards[key]; exists {
-					fw.ForwardingState = k8s.ForwardingStateFailed
-					fw.FailureReason = fmt.Sprintf("Port forwarding failed: %v", err)
-					fw.FailureTime = time.Now()
-				}
-				m.mu.Unlock()
-			}
-		case <-stopChan:
-			// Normal stop requested
-			return
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
 		}
-	}()
+	}
 
-	// Store forward info immediately as pending with lock
+	return m.StartForwardWithLocalPort(ctx, namespace, serviceName, remotePort, localPort)
+}
+
+// CancelPending aborts a forward that is still being established and forgets
+// it, so it shows as inactive again. It reports whether a pending forward was
+// found.
+func (m *Manager) CancelPending(namespace, serviceName string, remotePort int) bool {
 	m.mu.Lock()
-	m.forwards[key] = fw
-	m.mu.Unlock()
-	
-	select {
-	case <-readyChan:
-		// Mark as ready
-		m.mu.Lock()
-		fw.ForwardingState = k8s.ForwardingStateActive
-		m.mu.Unlock()
-		return localPort, nil
-	case <-ctx.Done():
-		m.mu.Lock()
-		fw.ForwardingState = k8s.ForwardingStateFailed
-		fw.FailureReason = "Context cancelled"
-		fw.FailureTime = time.Now()
-		m.mu.Unlock()
-		close(stopChan)
-		return 0, fmt.Errorf("context cancelled")
-	case <-time.After(10 * time.Second):
-		m.mu.Lock()
-		fw.ForwardingState = k8s.ForwardingStateFailed
-		fw.FailureReason = "Timeout waiting for port forward to be ready"
-		fw.FailureTime = time.Now()
-		m.mu.Unlock()
-		close(stopChan)
-		return 0, fmt.Errorf("timeout waiting for port forward to be ready")
+	defer m.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
+	fw, exists := m.forwards[key]
+	if !exists || fw.ForwardingState != k8s.ForwardingStatePending {
+		return false
+	}
+
+	if fw.cancel != nil {
+		fw.cancel(ErrForwardCancelled)
+	}
+	if fw.StopChan != nil {
+		closeStopChan(fw.StopChan)
 	}
+	delete(m.forwards, key)
+	m.emit(fw, EventStopped, "cancelled")
+	return true
 }
 
-func (m *Manager) StopForward(namespace, serviceName string, remotePort int) {
+func (m *Manager) StopAll() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	key := fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
-	if fw, exists := m.forwards[key]; exists {
+	for key, fw := range m.forwards {
 		// Safely close the channel if it exists and is not already closed
 		if fw.StopChan != nil {
-			select {
-			case <-fw.StopChan:
-				// Already closed
-			default:
-				close(fw.StopChan)
-			}
+			closeStopChan(fw.StopChan)
 		}
 		delete(m.forwards, key)
+		m.emit(fw, EventStopped, "")
 	}
 }
 
-func (m *Manager) StopAll() {
+// ActiveCount returns how many forwards are currently active
+func (m *Manager) ActiveCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := 0
+	for _, fw := range m.forwards {
+		if fw.ForwardingState == k8s.ForwardingStateActive {
+			count++
+		}
+	}
+	return count
+}
+
+// Summary aggregates the active forwards for an at-a-glance overview
+type Summary struct {
+	Active      int
+	Failed      int
+	OldestStart time.Time // StartedAt of the longest running active forward
+	BytesIn     int64
+	BytesOut    int64
+}
+
+// Summary returns the number of active and failed forwards, when the oldest
+// active one started and their combined traffic
+func (m *Manager) Summary() Summary {
+	return SummaryOf(m.GetAllForwards())
+}
+
+// ReapForwards stops the forwards in namespace (every namespace when empty)
+// whose service no longer exists according to exists, and returns their
+// keys. Pending forwards are left to finish or fail on their own.
+func (m *Manager) ReapForwards(namespace string, exists func(namespace, serviceName string) bool) []string {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	var reaped []string
 	for key, fw := range m.forwards {
-		// Safely close the channel if it exists and is not already closed
+		if namespace != "" && fw.Namespace != namespace {
+			continue
+		}
+		if fw.ForwardingState == k8s.ForwardingStatePending || exists(fw.Namespace, fw.Service) {
+			continue
+		}
 		if fw.StopChan != nil {
-			select {
-			case <-fw.StopChan:
-				// Already closed
-			default:
-				close(fw.StopChan)
-			}
+			closeStopChan(fw.StopChan)
 		}
 		delete(m.forwards, key)
+		m.emit(fw, EventStopped, "service deleted")
+		reaped = append(reaped, key)
 	}
+	sort.Strings(reaped)
+	return reaped
 }
 
 func (m *Manager) IsForwarding(namespace, serviceName string, remotePort int) bool {
@@ -375,9 +858,8 @@ func (m *Manager) IsServiceForwarding(namespace, serviceName string) bool {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	prefix := fmt.Sprintf("%s/%s:", namespace, serviceName)
-	for key := range m.forwards {
-		if strings.HasPrefix(key, prefix) {
+	for _, fw := range m.forwards {
+		if fw.isFor(namespace, serviceName) {
 			return true
 		}
 	}
@@ -390,10 +872,9 @@ func (m *Manager) GetActiveForwards(namespace, serviceName string) []ForwardInfo
 	defer m.mu.RUnlock()
 
 	var forwards []ForwardInfo
-	prefix := fmt.Sprintf("%s/%s:", namespace, serviceName)
-	for key, fw := range m.forwards {
-		if strings.HasPrefix(key, prefix) {
-			forwards = append(forwards, *fw)
+	for _, fw := range m.forwards {
+		if fw.isFor(namespace, serviceName) {
+			forwards = append(forwards, fw.snapshot())
 		}
 	}
 	return forwards
@@ -407,48 +888,200 @@ func (m *Manager) GetForwardInfo(namespace, serviceName string, remotePort int)
 	key := fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
 	if fw, exists := m.forwards[key]; exists {
 		// Return a copy to avoid race conditions
-		copy := *fw
+		copy := fw.snapshot()
 		return &copy
 	}
 	return nil
 }
 
-// Debug method to see all forwards
+// GetAllForwards returns copies of all forwards keyed by "namespace/service:port"
 func (m *Manager) GetAllForwards() map[string]*ForwardInfo {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	
 	result := make(map[string]*ForwardInfo)
 	for k, v := range m.forwards {
-		result[k] = v
+		copy := v.snapshot()
+		result[k] = &copy
 	}
 	return result
 }
 
-func getFreePort() (int, error) {
-	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
-	if err != nil {
-		return 0, err
+// failureReason turns an error into a capitalized FailureReason string
+func failureReason(err error) string {
+	reason := err.Error()
+	if reason == "" {
+		return reason
 	}
+	return strings.ToUpper(reason[:1]) + reason[1:]
+}
 
-	l, err := net.ListenTCP("tcp", addr)
-	if err != nil {
-		return 0, err
+// closeStopChan closes a stop channel unless it is already closed
+func closeStopChan(stopChan chan struct{}) {
+	select {
+	case <-stopChan:
+		// Already closed
+	default:
+		close(stopChan)
 	}
-	defer l.Close()
-	return l.Addr().(*net.TCPAddr).Port, nil
 }
 
-func isPortAvailable(port int) bool {
-	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", port))
-	if err != nil {
+// isClosed reports whether ch has been closed
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
 		return false
 	}
+}
 
-	l, err := net.ListenTCP("tcp", addr)
-	if err != nil {
-		return false
+// loopbackAddresses are the addresses "localhost" is forwarded on, in the
+// order clients usually try them
+var loopbackAddresses = []string{"127.0.0.1", "::1"}
+
+// listenAddresses returns the addresses a forward bound to bindAddress listens
+// on. "localhost" is whichever of the IPv4 and IPv6 loopback addresses this
+// host has, so IPv6-only hosts work and the availability checks look at the
+// same listeners the forward opens.
+func listenAddresses(bindAddress string) []string {
+	if bindAddress != "localhost" {
+		return []string{bindAddress}
+	}
+
+	var addrs []string
+	for _, addr := range loopbackAddresses {
+		l, err := net.Listen("tcp", net.JoinHostPort(addr, "0"))
+		if err != nil {
+			continue
+		}
+		l.Close()
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		// Let binding fail with a useful error
+		return []string{bindAddress}
+	}
+	return addrs
+}
+
+// getFreePort returns a port the OS picks that is free on every address a
+// forward to bindAddress listens on
+func getFreePort(bindAddress string) (int, error) {
+	addrs := listenAddresses(bindAddress)
+	for attempt := 0; attempt < maxConflictProbe; attempt++ {
+		l, err := net.Listen("tcp", net.JoinHostPort(addrs[0], "0"))
+		if err != nil {
+			return 0, err
+		}
+		port := l.Addr().(*net.TCPAddr).Port
+		l.Close()
+
+		if portFree(addrs[1:], port) {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("no port free on all of %s", strings.Join(addrs, ", "))
+}
+
+// allocateLocalPort picks the local port for fw and records it, all under the
+// lock, so concurrent starts never pick a port another forward just took
+// but hasn't bound yet
+func (m *Manager) allocateLocalPort(fw *ForwardInfo, bindAddress string, preferredLocalPort int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	allocated := m.allocatedPortsLocked()
+	available := func(port int) bool {
+		_, taken := allocated[port]
+		return !taken && IsPortAvailable(bindAddress, port)
+	}
+
+	var localPort int
+	if preferredLocalPort > 0 {
+		// Check if the preferred port is available
+		if available(preferredLocalPort) {
+			localPort = preferredLocalPort
+		} else if m.OnConflict == ConflictAuto {
+			port, ok := nextFreePort(preferredLocalPort, available)
+			if !ok {
+				var err error
+				if port, err = getUnallocatedPort(bindAddress, allocated); err != nil {
+					return 0, fmt.Errorf("failed to get free port: %w", err)
+				}
+			}
+			localPort = port
+		} else if owner, taken := allocated[preferredLocalPort]; taken {
+			return 0, fmt.Errorf("port %d is already in use by the forward of %s", preferredLocalPort, owner)
+		} else {
+			return 0, fmt.Errorf("port %d is already in use", preferredLocalPort)
+		}
+	} else {
+		// Get any available port
+		port, err := getUnallocatedPort(bindAddress, allocated)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get free port: %w", err)
+		}
+		localPort = port
+	}
+
+	fw.LocalPort = localPort
+	return localPort, nil
+}
+
+// AllocatedPorts returns the local ports kpf has assigned to forwards,
+// including pending ones, mapped to the namespace/service:port they serve
+func (m *Manager) AllocatedPorts() map[int]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.allocatedPortsLocked()
+}
+
+// allocatedPortsLocked is AllocatedPorts for callers holding the lock
+func (m *Manager) allocatedPortsLocked() map[int]string {
+	return AllocatedPortsOf(m.forwards)
+}
+
+// getUnallocatedPort returns a free port the OS picks that no other forward
+// has been assigned yet
+func getUnallocatedPort(bindAddress string, allocated map[int]string) (int, error) {
+	for attempt := 0; attempt < maxConflictProbe; attempt++ {
+		port, err := getFreePort(bindAddress)
+		if err != nil {
+			return 0, err
+		}
+		if _, taken := allocated[port]; !taken {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("no unallocated port found")
+}
+
+// nextFreePort returns the first available port among the few after from
+func nextFreePort(from int, available func(int) bool) (int, bool) {
+	for port := from + 1; port <= 65535 && port <= from+maxConflictProbe; port++ {
+		if available(port) {
+			return port, true
+		}
+	}
+	return 0, false
+}
+
+// IsPortAvailable reports whether a forward to bindAddress could listen on
+// port, on each of the addresses it listens on
+func IsPortAvailable(bindAddress string, port int) bool {
+	return portFree(listenAddresses(bindAddress), port)
+}
+
+// portFree reports whether port can be bound on every one of addrs
+func portFree(addrs []string, port int) bool {
+	for _, addr := range addrs {
+		l, err := net.Listen("tcp", net.JoinHostPort(addr, strconv.Itoa(port)))
+		if err != nil {
+			return false
+		}
+		l.Close()
 	}
-	defer l.Close()
 	return true
 }
@@ -0,0 +1,50 @@
+package portforward
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// ForbiddenError is a 403 from the API server, naming the RBAC permission a
+// forward needs so it can be passed on to a cluster admin
+type ForbiddenError struct {
+	Verb      string // e.g. "list"
+	Resource  string // e.g. "pods" or "pods/portforward"
+	Namespace string
+	Err       error
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("RBAC: you need permission to %s %s in namespace %s", e.Verb, e.Resource, e.Namespace)
+}
+
+func (e *ForbiddenError) Unwrap() error {
+	return e.Err
+}
+
+// asForbidden returns err as a ForbiddenError if the API server refused it,
+// and nil otherwise
+func asForbidden(err error, verb, resource, namespace string) error {
+	if err == nil || !apierrors.IsForbidden(err) {
+		return nil
+	}
+	return &ForbiddenError{Verb: verb, Resource: resource, Namespace: namespace, Err: err}
+}
+
+// rbacDialer explains a refused upgrade to the portforward subresource. The
+// port forwarder only keeps the text of dial errors, so the permission has to
+// be in the message.
+type rbacDialer struct {
+	httpstream.Dialer
+	namespace string
+}
+
+func (d *rbacDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := d.Dialer.Dial(protocols...)
+	if forbidden := asForbidden(err, "create", "pods/portforward", d.namespace); forbidden != nil {
+		return nil, protocol, forbidden
+	}
+	return conn, protocol, err
+}
@@ -0,0 +1,164 @@
+package portforward
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+)
+
+// SavedForward is the persisted description of an active forward
+type SavedForward struct {
+	Namespace  string `json:"namespace"`
+	Service    string `json:"service"`
+	RemotePort int    `json:"remotePort"`
+	LocalPort  int    `json:"localPort"`
+}
+
+// DefaultStatePath returns the file used to persist forwards between sessions
+func DefaultStatePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "kpf", "forwards.json")
+}
+
+// SavedForwards returns the currently active forwards, sorted by
+// namespace, service and port
+func (m *Manager) SavedForwards() []SavedForward {
+	m.mu.RLock()
+	saved := make([]SavedForward, 0, len(m.forwards))
+	for _, fw := range m.forwards {
+		if fw.ForwardingState != k8s.ForwardingStateActive {
+			continue
+		}
+		saved = append(saved, SavedForward{
+			Namespace:  fw.Namespace,
+			Service:    fw.Service,
+			RemotePort: fw.RemotePort,
+			LocalPort:  fw.LocalPort,
+		})
+	}
+	m.mu.RUnlock()
+
+	// Sorted to keep the state file stable between saves
+	sort.Slice(saved, func(i, j int) bool {
+		if saved[i].Namespace != saved[j].Namespace {
+			return saved[i].Namespace < saved[j].Namespace
+		}
+		if saved[i].Service != saved[j].Service {
+			return saved[i].Service < saved[j].Service
+		}
+		return saved[i].RemotePort < saved[j].RemotePort
+	})
+	return saved
+}
+
+// SaveState writes the currently active forwards to path as JSON
+func (m *Manager) SaveState(path string) error {
+	data, err := json.MarshalIndent(m.SavedForwards(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode forward state: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write forward state: %w", err)
+	}
+	return nil
+}
+
+// LoadState reads the forwards persisted at path. A missing file is not an error.
+func LoadState(path string) ([]SavedForward, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read forward state: %w", err)
+	}
+
+	var saved []SavedForward
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to decode forward state: %w", err)
+	}
+	return saved, nil
+}
+
+// RestoreState re-establishes the forwards persisted at path, preferring the
+// local ports they used before. Forwards that cannot be restored are reported
+// in the returned error; the others are still started.
+func (m *Manager) RestoreState(ctx context.Context, path string) error {
+	saved, err := LoadState(path)
+	if err != nil {
+		return err
+	}
+	return m.StartForwards(ctx, saved)
+}
+
+// StartForwards starts the given forwards concurrently, reusing each one's
+// local port when set. Failures are joined into the returned error.
+func (m *Manager) StartForwards(ctx context.Context, forwards []SavedForward) error {
+	errs := make([]error, len(forwards))
+	done := make(chan struct{})
+	for i, sf := range forwards {
+		go func(i int, sf SavedForward) {
+			defer func() { done <- struct{}{} }()
+
+			_, err := m.StartForwardWithLocalPort(ctx, sf.Namespace, sf.Service, sf.RemotePort, sf.LocalPort)
+			if err != nil && !errors.Is(err, ErrAlreadyForwarding) {
+				errs[i] = fmt.Errorf("%s/%s:%d: %w", sf.Namespace, sf.Service, sf.RemotePort, err)
+			}
+		}(i, sf)
+	}
+	for range forwards {
+		<-done
+	}
+
+	return errors.Join(errs...)
+}
+
+// ParseForwardSpec parses a forward given as "namespace/service:port" or
+// "namespace/service:port:localPort"
+func ParseForwardSpec(spec string) (SavedForward, error) {
+	invalid := fmt.Errorf("invalid forward %q, expected namespace/service:port[:localPort]", spec)
+
+	target, ports, ok := strings.Cut(spec, ":")
+	if !ok {
+		return SavedForward{}, invalid
+	}
+	namespace, service, ok := strings.Cut(target, "/")
+	if !ok || namespace == "" || service == "" || strings.Contains(service, "/") {
+		return SavedForward{}, invalid
+	}
+
+	remote, local, hasLocal := strings.Cut(ports, ":")
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil || remotePort < 1 || remotePort > 65535 {
+		return SavedForward{}, invalid
+	}
+	localPort := 0
+	if hasLocal {
+		localPort, err = strconv.Atoi(local)
+		if err != nil || localPort < 1 || localPort > 65535 {
+			return SavedForward{}, invalid
+		}
+	}
+
+	return SavedForward{
+		Namespace:  namespace,
+		Service:    service,
+		RemotePort: remotePort,
+		LocalPort:  localPort,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package portforward
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// trafficCounter tracks the bytes moved through a forward's data streams
+type trafficCounter struct {
+	bytesIn  atomic.Int64 // Received from the pod
+	bytesOut atomic.Int64 // Sent to the pod
+}
+
+// reset forgets the traffic counted so far
+func (c *trafficCounter) reset() {
+	c.bytesIn.Store(0)
+	c.bytesOut.Store(0)
+}
+
+// countingDialer wraps a dialer so every data stream it opens is counted
+type countingDialer struct {
+	httpstream.Dialer
+	counter *trafficCounter
+}
+
+func (d *countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, protocol, err
+	}
+	return &countingConnection{Connection: conn, counter: d.counter}, protocol, nil
+}
+
+type countingConnection struct {
+	httpstream.Connection
+	counter *trafficCounter
+}
+
+func (c *countingConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	stream, err := c.Connection.CreateStream(headers)
+	if err != nil || headers.Get(corev1.StreamType) != corev1.StreamTypeData {
+		return stream, err
+	}
+	return &countingStream{Stream: stream, counter: c.counter}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+	counter *trafficCounter
+}
+
+func (s *countingStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.counter.bytesIn.Add(int64(n))
+	return n, err
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.counter.bytesOut.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,80 @@
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	// verifyAttempts is how often a ready forward is probed before giving up
+	verifyAttempts = 5
+
+	// verifyInterval is the pause between probes
+	verifyInterval = 300 * time.Millisecond
+
+	// verifyWait bounds connecting and waiting for the pod to close a probe
+	verifyWait = 500 * time.Millisecond
+)
+
+// verifyForward checks that connections through a forward that just became
+// ready actually reach something listening in the pod. The local listener
+// accepts every connection, so a refused connection in the pod only shows
+// as the forwarder closing the probe right away.
+func verifyForward(ctx context.Context, bindAddress string, localPort int) error {
+	addr := net.JoinHostPort(probeHost(bindAddress), strconv.Itoa(localPort))
+
+	var err error
+	for attempt := 0; attempt < verifyAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(verifyInterval):
+			}
+		}
+		if err = probeForward(addr); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("forward is listening but the pod does not accept connections on the target port: %v", err)
+}
+
+// probeForward opens one connection through the forward. The pod accepted it
+// if it sends data or keeps the connection open for verifyWait.
+func probeForward(addr string) error {
+	conn, err := net.DialTimeout("tcp", addr, verifyWait)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(verifyWait))
+	_, err = conn.Read(make([]byte, 1))
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return nil
+	case errors.Is(err, io.EOF):
+		return errors.New("connection closed by the forwarder")
+	default:
+		return err
+	}
+}
+
+// probeHost is the address to dial to reach a forward bound to bindAddress
+func probeHost(bindAddress string) string {
+	if ip := net.ParseIP(bindAddress); ip != nil && ip.IsUnspecified() {
+		if ip.To4() != nil {
+			return "127.0.0.1"
+		}
+		return "::1"
+	}
+	return bindAddress
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"context"
+	"io"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"k8s.io/client-go/rest"
+)
+
+// ServiceLister is the cluster access the TUI needs. *k8s.Client implements
+// it against a real cluster, the demo mode with canned data.
+type ServiceLister interface {
+	GetServices(ctx context.Context) ([]k8s.ServiceInfo, error)
+	WatchServices(ctx context.Context) (<-chan []k8s.ServiceInfo, error)
+	InvalidateCache()
+	LabelSelector() string
+	NamespaceFilter() k8s.NamespaceFilter
+
+	CurrentContext() string
+	ListContexts() ([]string, error)
+	SwitchContext(name string) error
+	GetConfig() *rest.Config
+
+	GetServiceSelector(ctx context.Context, namespace, serviceName string) (string, error)
+	ResolveServicePortName(ctx context.Context, namespace, serviceName, portName string) (int, error)
+	GetPodsForService(ctx context.Context, namespace, serviceName string) ([]k8s.PodInfo, error)
+	GetNamespacePods(ctx context.Context, namespace string) ([]k8s.PodInfo, error)
+	GetPodMetrics(ctx context.Context, namespace, selector string) (map[string]k8s.PodMetrics, error)
+	GetDeploymentForService(ctx context.Context, namespace, serviceName string) (*k8s.DeploymentInfo, error)
+	GetIngresses(ctx context.Context) ([]k8s.IngressInfo, error)
+	StreamPodLogs(ctx context.Context, namespace, podName string, tailLines int64) (io.ReadCloser, error)
+}
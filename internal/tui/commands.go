@@ -2,25 +2,45 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grumpylabs/kpf/internal/clipboard"
 	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
 )
 
 type servicesLoadedMsg struct {
 	services []k8s.ServiceInfo
+	watched  bool // Delivered by the service watch, which must be re-armed
+	err      error
+}
+
+// serviceWatchStartedMsg carries the update channel of a newly started service watch
+type serviceWatchStartedMsg struct {
+	updates <-chan []k8s.ServiceInfo
+	err     error
 }
 
 type servicesRefreshMsg struct{}
 
 type portForwardStartedMsg struct {
-	localPort int
+	namespace     string
+	service       string
+	port          int
+	localPort     int
+	requestedPort int // Local port asked for, if any
 }
 
-type portForwardStoppedMsg struct{}
+// portForwardStoppedMsg resets the selected row to inactive. A non-empty
+// status is shown in the status line.
+type portForwardStoppedMsg struct {
+	status string
+}
 
 type portForwardFailedMsg struct {
 	namespace string
@@ -35,6 +55,26 @@ type portForwardPendingMsg struct {
 	port      int
 }
 
+// bulkForwardMsg reports the outcome of starting or stopping every port of a service
+type bulkForwardMsg struct {
+	summary string
+}
+
+// startupForwardsMsg reports the result of starting the --forward targets
+type startupForwardsMsg struct {
+	err error
+}
+
+// forwardsRestoredMsg reports the outcome of restoring persisted forwards
+type forwardsRestoredMsg struct {
+	err error
+}
+
+// statusMsg sets the status line text
+type statusMsg struct {
+	text string
+}
+
 type portConflictMsg struct {
 	servicePort int
 	remotePort  int
@@ -47,22 +87,223 @@ type errorMsg struct {
 
 type clusterInfoMsg struct {
 	context string
+	host    string
 }
 
 type deploymentLoadedMsg struct {
 	deployment *k8s.DeploymentInfo
 }
 
+type podsLoadedMsg struct {
+	pods []k8s.PodInfo
+	err  error
+}
+
+// podMetricsLoadedMsg carries the resource usage of the detail view's pods
+type podMetricsLoadedMsg struct {
+	metrics map[string]k8s.PodMetrics
+	err     error
+}
+
+// pickerPodsLoadedMsg carries the pods offered by the pod picker
+type pickerPodsLoadedMsg struct {
+	pods []k8s.PodInfo
+	err  error
+
+	// The service has no pods, so all pods of its namespace are offered
+	namespaceWide bool
+}
+
+// ingressesLoadedMsg carries the ingress entries shown in the ingress view
+type ingressesLoadedMsg struct {
+	ingresses []k8s.IngressInfo
+	err       error
+}
+
+// contextsLoadedMsg carries the kubeconfig contexts offered by the context switcher
+type contextsLoadedMsg struct {
+	contexts []string
+	err      error
+}
+
+// contextSwitchedMsg reports the outcome of switching kubeconfig contexts
+type contextSwitchedMsg struct {
+	context string
+	stopped int
+	err     error
+}
+
+// forwardEventMsg carries a lifecycle event of the forward manager
+type forwardEventMsg struct {
+	event portforward.Event
+}
+
+// waitForForwardEvent blocks until the forward manager emits an event
+func (m *Model) waitForForwardEvent() tea.Cmd {
+	events := m.forwardEvents
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return nil
+		}
+		return forwardEventMsg{event: event}
+	}
+}
+
+// forwardSyncTickMsg triggers a re-sync of row state with the forward manager
+type forwardSyncTickMsg struct{}
+
+// forwardSyncInterval is how often rows are re-synced with the forward manager
+const forwardSyncInterval = time.Second
+
+func forwardSyncTick() tea.Cmd {
+	return tea.Tick(forwardSyncInterval, func(time.Time) tea.Msg {
+		return forwardSyncTickMsg{}
+	})
+}
+
+// autoRefreshTickMsg reloads the service list on the --refresh-interval
+type autoRefreshTickMsg struct{}
+
+func autoRefreshTick(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return autoRefreshTickMsg{}
+	})
+}
+
+// togglePauseRefresh pauses or resumes the auto-refresh and the service
+// watch. Resuming catches up right away.
+func (m *Model) togglePauseRefresh() tea.Cmd {
+	if m.options.RefreshInterval <= 0 && m.serviceWatch == nil {
+		m.statusMessage = "Nothing to pause, start kpf with --refresh-interval or --watch"
+		return clearStatusAfter(m.statusMessage)
+	}
+
+	m.refreshPaused = !m.refreshPaused
+	if m.refreshPaused {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if services := m.pausedServices; services != nil {
+		m.pausedServices = nil
+		cmds = append(cmds, func() tea.Msg { return servicesLoadedMsg{services: services} })
+	}
+	if m.options.RefreshInterval > 0 {
+		cmds = append(cmds, m.loadServices)
+	}
+	return tea.Batch(cmds...)
+}
+
+// autoRefreshIndicator describes how the service list is kept up to date,
+// for the header
+func (m *Model) autoRefreshIndicator() string {
+	var mode string
+	switch {
+	case m.serviceWatch != nil:
+		mode = "live"
+	case m.options.RefreshInterval > 0:
+		mode = fmt.Sprintf("auto-refresh every %s", m.options.RefreshInterval)
+	default:
+		return ""
+	}
+	if m.refreshPaused {
+		return fmt.Sprintf(" - %s, paused (space resumes)", mode)
+	}
+	return " - " + mode
+}
+
+// clearStatusMsg clears the status line if it still shows text
+type clearStatusMsg struct {
+	text string
+}
+
+// statusTimeout is how long a transient status message stays visible
+const statusTimeout = 4 * time.Second
+
+// clearStatusAfter clears text from the status line after statusTimeout,
+// unless it has been replaced in the meantime
+func clearStatusAfter(text string) tea.Cmd {
+	return tea.Tick(statusTimeout, func(time.Time) tea.Msg {
+		return clearStatusMsg{text: text}
+	})
+}
+
+// ShutdownMsg asks the TUI to save state, stop all forwards and quit. It is
+// sent when the process receives SIGINT or SIGTERM.
+type ShutdownMsg struct{}
+
+// pendingTickMsg re-renders pending rows so their spinner and timer advance
+type pendingTickMsg struct{}
+
+// pendingTickInterval is how often pending rows are re-rendered
+const pendingTickInterval = 200 * time.Millisecond
+
+func pendingTick() tea.Cmd {
+	return tea.Tick(pendingTickInterval, func(time.Time) tea.Msg {
+		return pendingTickMsg{}
+	})
+}
+
+// loadingTickMsg re-renders the loading indicator shown until the first
+// service list arrives
+type loadingTickMsg struct{}
+
+func loadingTick() tea.Cmd {
+	return tea.Tick(pendingTickInterval, func(time.Time) tea.Msg {
+		return loadingTickMsg{}
+	})
+}
+
+// forwardContext returns the context used to start a forward. It outlives the
+// manager's ready timeout so the manager reports timeouts itself.
+func (m *Model) forwardContext() (context.Context, context.CancelFunc) {
+	timeout := m.options.ForwardTimeout
+	if timeout <= 0 {
+		timeout = portforward.DefaultReadyTimeout
+	}
+	return context.WithTimeout(context.Background(), timeout+5*time.Second)
+}
+
+// startForward forwards a service port through whichever pod the service
+// selects, on localPort if it is free or else as the conflict policy says.
+// A localPort of 0 picks any free port.
+func (m *Model) startForward(ctx context.Context, namespace, service string, port, localPort int) (int, error) {
+	return m.startForwardToPod(ctx, namespace, service, "", port, localPort)
+}
+
+// startForwardToPod is startForward through a specific pod
+func (m *Model) startForwardToPod(ctx context.Context, namespace, service, podName string, port, localPort int) (int, error) {
+	return m.forwardManager.StartForwardToContainer(ctx, namespace, service, podName, "", port, localPort)
+}
+
+// isServiceForwarding reports whether any port of a service is forwarded
+func isServiceForwarding(forwards map[string]*portforward.ForwardInfo, namespace, service string) bool {
+	for _, fw := range forwards {
+		if fw.Namespace == namespace && fw.Service == service {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Model) loadServices() tea.Msg {
 	ctx := context.Background()
 	services, err := m.client.GetServices(ctx)
 	if err != nil {
-		return errorMsg{err: err}
+		return servicesLoadedMsg{err: err}
 	}
 
+	m.mergeForwardState(services)
+	return servicesLoadedMsg{services: services}
+}
+
+// mergeForwardState fills in the per-port forwarding state from the forward manager
+func (m *Model) mergeForwardState(services []k8s.ServiceInfo) {
+	forwards := m.forwardManager.GetAllForwards()
 	for i := range services {
 		// Check if any port is forwarding
-		services[i].IsForwarding = m.forwardManager.IsServiceForwarding(services[i].Namespace, services[i].Name)
+		services[i].IsForwarding = isServiceForwarding(forwards, services[i].Namespace, services[i].Name)
 		
 		// Update per-port forwarding status
 		for j := range services[i].Ports {
@@ -74,14 +315,24 @@ func (m *Model) loadServices() tea.Msg {
 				port.ForwardingState = fwInfo.ForwardingState
 				port.ForwardingPort = fwInfo.LocalPort
 				port.ForwardStartTime = fwInfo.StartedAt
+				port.ForwardPod = fwInfo.PodName
+				port.ForwardTargetPort = fwInfo.TargetPort
+				port.ForwardContainer = fwInfo.Container
 				port.FailureReason = fwInfo.FailureReason
 				port.FailureTime = fwInfo.FailureTime
+				port.BytesIn = fwInfo.BytesIn
+				port.BytesOut = fwInfo.BytesOut
 			} else {
 				port.ForwardingState = k8s.ForwardingStateInactive
 				port.ForwardingPort = 0
 				port.ForwardStartTime = time.Time{}
+				port.ForwardPod = ""
+				port.ForwardTargetPort = 0
+				port.ForwardContainer = ""
 				port.FailureReason = ""
 				port.FailureTime = time.Time{}
+				port.BytesIn = 0
+				port.BytesOut = 0
 			}
 		}
 		
@@ -95,8 +346,31 @@ func (m *Model) loadServices() tea.Msg {
 			}
 		}
 	}
+}
 
-	return servicesLoadedMsg{services: services}
+// startServiceWatch starts streaming service list changes from the cluster
+func (m *Model) startServiceWatch() tea.Msg {
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := m.client.WatchServices(ctx)
+	if err != nil {
+		cancel()
+		return serviceWatchStartedMsg{err: err}
+	}
+
+	m.stopWatch = cancel
+	return serviceWatchStartedMsg{updates: updates}
+}
+
+// waitForServiceUpdate blocks until the service watch delivers a new list
+func (m *Model) waitForServiceUpdate(updates <-chan []k8s.ServiceInfo) tea.Cmd {
+	return func() tea.Msg {
+		services, ok := <-updates
+		if !ok {
+			return nil
+		}
+		m.mergeForwardState(services)
+		return servicesLoadedMsg{services: services, watched: true}
+	}
 }
 
 func (m *Model) startPortForward() tea.Msg {
@@ -115,20 +389,42 @@ func (m *Model) startPortForward() tea.Msg {
 	}
 
 	port := selectedRow.PortInfo
+
+	if svc.Type == "ExternalName" {
+		// ExternalName services are DNS aliases with no pods to forward to
+		return portForwardFailedMsg{
+			namespace: svc.Namespace,
+			service:   svc.Name,
+			port:      int(port.Port),
+			reason:    externalNameReason(svc),
+		}
+	}
 	
 	if selectedRow.PortInfo.ForwardingState == k8s.ForwardingStateActive {
 		// Port is active, stop it
 		m.forwardManager.StopForward(svc.Namespace, svc.Name, int(port.Port))
 		return portForwardStoppedMsg{}
+	} else if selectedRow.PortInfo.ForwardingState == k8s.ForwardingStatePending {
+		// Port is still being set up, give up on it
+		return m.cancelPendingForward()
 	} else if selectedRow.PortInfo.ForwardingState == k8s.ForwardingStateFailed {
 		// Port failed, clear the failure and try again
 		m.forwardManager.StopForward(svc.Namespace, svc.Name, int(port.Port)) // Clean up failed forward
 		
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		ctx, cancel := m.forwardContext()
 		defer cancel()
 		
-		localPort, err := m.forwardManager.StartForwardWithLocalPort(ctx, svc.Namespace, svc.Name, int(port.Port), int(port.Port))
+		requestedPort := m.preferredLocalPort(svc.Namespace, svc.Name, int(port.Port))
+		localPort, err := m.startForward(ctx, svc.Namespace, svc.Name, int(port.Port), requestedPort)
 		if err != nil {
+			if errors.Is(err, portforward.ErrForwardCancelled) {
+				// Cancelled from the UI, which already reset the row
+				return nil
+			}
+			if errors.Is(err, portforward.ErrAlreadyForwarding) {
+				// Re-triggered while the forward is still being set up
+				return statusMsg{text: fmt.Sprintf("%s/%s:%d is already being forwarded", svc.Namespace, svc.Name, port.Port)}
+			}
 			if strings.Contains(err.Error(), "already in use") {
 				return portConflictMsg{servicePort: int(port.Port), remotePort: int(port.Port)}
 			}
@@ -137,17 +433,26 @@ func (m *Model) startPortForward() tea.Msg {
 				namespace: svc.Namespace,
 				service:   svc.Name,
 				port:      int(port.Port),
-				reason:    err.Error(),
+				reason:    failureText(err),
 			}
 		}
-		return portForwardStartedMsg{localPort: localPort}
+		return portForwardStartedMsg{namespace: svc.Namespace, service: svc.Name, port: int(port.Port), localPort: localPort, requestedPort: requestedPort}
 	} else {
 		// Port is inactive, start it with timeout context
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		ctx, cancel := m.forwardContext()
 		defer cancel()
 		
-		localPort, err := m.forwardManager.StartForwardWithLocalPort(ctx, svc.Namespace, svc.Name, int(port.Port), int(port.Port))
+		requestedPort := m.preferredLocalPort(svc.Namespace, svc.Name, int(port.Port))
+		localPort, err := m.startForward(ctx, svc.Namespace, svc.Name, int(port.Port), requestedPort)
 		if err != nil {
+			if errors.Is(err, portforward.ErrForwardCancelled) {
+				// Cancelled from the UI, which already reset the row
+				return nil
+			}
+			if errors.Is(err, portforward.ErrAlreadyForwarding) {
+				// Re-triggered while the forward is still being set up
+				return statusMsg{text: fmt.Sprintf("%s/%s:%d is already being forwarded", svc.Namespace, svc.Name, port.Port)}
+			}
 			if strings.Contains(err.Error(), "already in use") {
 				return portConflictMsg{servicePort: int(port.Port), remotePort: int(port.Port)}
 			}
@@ -156,37 +461,223 @@ func (m *Model) startPortForward() tea.Msg {
 				namespace: svc.Namespace,
 				service:   svc.Name,
 				port:      int(port.Port),
-				reason:    err.Error(),
+				reason:    failureText(err),
 			}
 		}
-		return portForwardStartedMsg{localPort: localPort}
+		return portForwardStartedMsg{namespace: svc.Namespace, service: svc.Name, port: int(port.Port), localPort: localPort, requestedPort: requestedPort}
 	}
 }
 
 
 
+// nudgeLocalPort retries the selected failed forward on the local port delta
+// away from the one it last asked for, without the port input view. Repeated
+// nudges keep moving from the last nudged port.
+func (m *Model) nudgeLocalPort(delta int) tea.Cmd {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil || selectedRow.PortInfo.ForwardingState != k8s.ForwardingStateFailed {
+		m.statusMessage = "Only failed forwards can be retried on another local port"
+		return clearStatusAfter(m.statusMessage)
+	}
+	namespace, service := selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name
+	port := int(selectedRow.PortInfo.Port)
+
+	key := portforward.LocalPortKey(namespace, service, port)
+	localPort, ok := m.nudgedPorts[key]
+	if !ok {
+		localPort = m.preferredLocalPort(namespace, service, port)
+	}
+	// Same range the port input view accepts
+	localPort = min(max(localPort+delta, m.minLocalPort()), 65535)
+	m.nudgedPorts[key] = localPort
+	m.statusMessage = fmt.Sprintf("Retrying %s/%s:%d on local port %d...", namespace, service, port, localPort)
+
+	return tea.Batch(
+		func() tea.Msg {
+			return portForwardPendingMsg{namespace: namespace, service: service, port: port}
+		},
+		func() tea.Msg {
+			// Clean up the failed forward so it can be retried
+			m.forwardManager.StopForward(namespace, service, port)
+
+			ctx, cancel := m.forwardContext()
+			defer cancel()
+
+			started, err := m.startForward(ctx, namespace, service, port, localPort)
+			if errors.Is(err, portforward.ErrForwardCancelled) {
+				return nil
+			}
+			if err != nil {
+				// Leave the row failed, so the next nudge can try further on
+				return portForwardFailedMsg{namespace: namespace, service: service, port: port, reason: err.Error()}
+			}
+			return portForwardStartedMsg{namespace: namespace, service: service, port: port, localPort: started, requestedPort: localPort}
+		},
+	)
+}
+
+// minUnprivilegedPort is the lowest port non-root users can usually bind
+const minUnprivilegedPort = 1024
+
+// minLocalPort is the lowest local port the user may ask for
+func (m *Model) minLocalPort() int {
+	if m.options.AllowPrivilegedPorts {
+		return 1
+	}
+	return minUnprivilegedPort
+}
+
+// validateLocalPort checks a local port the user asked for. The port input
+// view's hint and submitting it both use it, so they agree.
+func (m *Model) validateLocalPort(port int) error {
+	if port < m.minLocalPort() || port > 65535 {
+		return fmt.Errorf("port must be between %d-65535", m.minLocalPort())
+	}
+	return nil
+}
+
+// parseLocalPort parses and validates a typed local port
+func (m *Model) parseLocalPort(input string) (int, error) {
+	var port int
+	if n, err := fmt.Sscanf(input, "%d", &port); n != 1 || err != nil {
+		return 0, fmt.Errorf("invalid port number: %s", input)
+	}
+	return port, m.validateLocalPort(port)
+}
+
+// externalNameReason explains why an ExternalName service can't be forwarded
+func externalNameReason(svc *k8s.ServiceInfo) string {
+	target := ""
+	if svc.Service != nil {
+		target = svc.Service.Spec.ExternalName
+	}
+	return fmt.Sprintf("%s/%s is an ExternalName service (CNAME to %s) with no pods, so it can't be port-forwarded; connect to %s directly",
+		svc.Namespace, svc.Name, target, target)
+}
+
+// preferredLocalPort picks the local port to try first for a service port:
+// a configured mapping, then the port used last time, then the remote port
+func (m *Model) preferredLocalPort(namespace, service string, port int) int {
+	key := portforward.LocalPortKey(namespace, service, port)
+	if local, ok := m.options.LocalPorts[key]; ok {
+		return local
+	}
+	if local, ok := m.lastLocalPorts[key]; ok {
+		return local
+	}
+	return port
+}
+
+// toggleAllPortForwards stops every forward of the selected service if any are
+// running, otherwise starts a forward for each of its ports concurrently
+func (m *Model) toggleAllPortForwards() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.ServiceData.Name == "" {
+		return nil
+	}
+
+	svc := selectedRow.ServiceData
+	if len(svc.Ports) == 0 {
+		return bulkForwardMsg{summary: fmt.Sprintf("%s/%s has no ports to forward", svc.Namespace, svc.Name)}
+	}
+	if svc.Type == "ExternalName" {
+		return bulkForwardMsg{summary: externalNameReason(&svc)}
+	}
+
+	if isServiceForwarding(m.forwardManager.GetAllForwards(), svc.Namespace, svc.Name) {
+		for _, port := range svc.Ports {
+			m.forwardManager.StopForward(svc.Namespace, svc.Name, int(port.Port))
+		}
+		return bulkForwardMsg{summary: fmt.Sprintf("Stopped %d port forwards for %s/%s", len(svc.Ports), svc.Namespace, svc.Name)}
+	}
+
+	type result struct {
+		port      int32
+		localPort int
+		err       error
+	}
+	results := make([]result, len(svc.Ports))
+
+	var wg sync.WaitGroup
+	for i, port := range svc.Ports {
+		wg.Add(1)
+		go func(i int, port k8s.PortInfo) {
+			defer wg.Done()
+
+			// Clean up a previously failed forward so it can be retried
+			if fwInfo := m.forwardManager.GetForwardInfo(svc.Namespace, svc.Name, int(port.Port)); fwInfo != nil && fwInfo.ForwardingState == k8s.ForwardingStateFailed {
+				m.forwardManager.StopForward(svc.Namespace, svc.Name, int(port.Port))
+			}
+
+			ctx, cancel := m.forwardContext()
+			defer cancel()
+
+			localPort, err := m.startForward(ctx, svc.Namespace, svc.Name, int(port.Port), m.preferredLocalPort(svc.Namespace, svc.Name, int(port.Port)))
+			results[i] = result{port: port.Port, localPort: localPort, err: err}
+		}(i, port)
+	}
+	wg.Wait()
+
+	var started, failed []string
+	for _, r := range results {
+		if errors.Is(r.err, portforward.ErrAlreadyForwarding) {
+			started = append(started, fmt.Sprintf("%d (already)", r.port))
+		} else if r.err != nil {
+			failed = append(failed, fmt.Sprintf("%d (%v)", r.port, r.err))
+		} else {
+			started = append(started, fmt.Sprintf("%d→%d", r.port, r.localPort))
+		}
+	}
+
+	summary := fmt.Sprintf("%s/%s: %d/%d ports forwarded", svc.Namespace, svc.Name, len(started), len(results))
+	if len(started) > 0 {
+		summary += " [" + strings.Join(started, ", ") + "]"
+	}
+	if len(failed) > 0 {
+		summary += "; failed: " + strings.Join(failed, ", ")
+	}
+	return bulkForwardMsg{summary: summary}
+}
+
+// selectedForwardPending reports whether the selected port is still being
+// forwarded
+func (m *Model) selectedForwardPending() bool {
+	selectedRow := m.table.GetSelectedRow()
+	return selectedRow != nil && selectedRow.PortInfo != nil && selectedRow.PortInfo.ForwardingState == k8s.ForwardingStatePending
+}
+
+// cancelPendingForward aborts the selected forward while it is still pending
+func (m *Model) cancelPendingForward() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+	namespace, service := selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name
+	port := int(selectedRow.PortInfo.Port)
+
+	if !m.forwardManager.CancelPending(namespace, service, port) {
+		// It became ready or failed in the meantime
+		return statusMsg{text: fmt.Sprintf("%s/%s:%d is no longer pending", namespace, service, port)}
+	}
+	return portForwardStoppedMsg{status: fmt.Sprintf("Cancelled forward to %s/%s:%d", namespace, service, port)}
+}
+
 func (m *Model) startPortForwardWithUserPort() tea.Msg {
 	svc := m.table.GetSelected()
 	if svc == nil {
 		return errorMsg{err: fmt.Errorf("no service selected")}
 	}
 
-	// Parse user input
-	var userPort int
-	if _, err := fmt.Sscanf(m.portInput, "%d", &userPort); err != nil {
-		return errorMsg{err: fmt.Errorf("invalid port number: %s", m.portInput)}
-	}
-
-	// Validate port range
-	if userPort < 1024 || userPort > 65535 {
-		return errorMsg{err: fmt.Errorf("port must be between 1024-65535")}
+	userPort, err := m.parseLocalPort(m.portInput)
+	if err != nil {
+		return errorMsg{err: err}
 	}
 
 	// Try to start port forward with user's port with a timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	ctx, cancel := m.forwardContext()
 	defer cancel()
 	
-	localPort, err := m.forwardManager.StartForwardWithLocalPort(ctx, svc.Namespace, svc.Name, m.remotePort, userPort)
+	localPort, err := m.startForward(ctx, svc.Namespace, svc.Name, m.remotePort, userPort)
 	if err != nil {
 		if strings.Contains(err.Error(), "timeout") {
 			return errorMsg{err: fmt.Errorf("timeout establishing port forward to %s/%s:%d", svc.Namespace, svc.Name, m.remotePort)}
@@ -198,18 +689,183 @@ func (m *Model) startPortForwardWithUserPort() tea.Msg {
 	m.portInput = ""
 	m.viewMode = listView
 	
-	return portForwardStartedMsg{localPort: localPort}
+	return portForwardStartedMsg{namespace: svc.Namespace, service: svc.Name, port: m.remotePort, localPort: localPort}
+}
+
+// restoreForwards re-establishes the forwards saved by the previous session
+func (m *Model) restoreForwards() tea.Msg {
+	if m.statePath == "" {
+		return nil
+	}
+
+	ctx, cancel := m.forwardContext()
+	defer cancel()
+
+	return forwardsRestoredMsg{err: m.forwardStore.RestoreState(ctx, m.statePath)}
+}
+
+// startStartupForwards starts the forwards requested with --forward
+func (m *Model) startStartupForwards() tea.Msg {
+	ctx, cancel := m.forwardContext()
+	defer cancel()
+
+	return startupForwardsMsg{err: m.forwardStore.StartForwards(ctx, m.options.Forwards)}
+}
+
+// shutdown persists the active forwards for the next session and stops them
+func (m *Model) shutdown() {
+	// Best effort, failing to save must never block quitting
+	if m.statePath != "" {
+		_ = m.forwardStore.SaveState(m.statePath)
+	}
+	if m.localPortsPath != "" {
+		_ = m.forwardStore.SaveLocalPorts(m.localPortsPath, m.lastLocalPorts)
+	}
+	if m.stopWatch != nil {
+		m.stopWatch()
+	}
+	m.closeLogs()
+	m.forwardManager.StopAll()
+}
+
+// quitDetached quits, handing the active forwards over to a background
+// process that is started once the TUI has exited
+func (m *Model) quitDetached() tea.Cmd {
+	if m.forwardStore != nil {
+		m.detachedForwards = m.forwardStore.SavedForwards()
+	}
+	m.shutdown()
+	return tea.Quit
+}
+
+// DetachedForwards returns the forwards the user chose to keep running after
+// quitting
+func (m *Model) DetachedForwards() []portforward.SavedForward {
+	return m.detachedForwards
+}
+
+// copyForwardAddress copies the selected row's local forward address to the clipboard
+func (m *Model) copyForwardAddress() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil || selectedRow.ForwardingState != k8s.ForwardingStateActive {
+		return statusMsg{text: "Selected port is not being forwarded"}
+	}
+
+	address := fmt.Sprintf("localhost:%d", selectedRow.ForwardingPort)
+	if err := clipboard.Write(address); err != nil {
+		return statusMsg{text: err.Error()}
+	}
+	return statusMsg{text: fmt.Sprintf("Copied %s to clipboard", address)}
+}
+
+// copyForwardPod copies the name of the pod behind the selected active
+// forward to the clipboard
+func (m *Model) copyForwardPod() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil || selectedRow.ForwardingState != k8s.ForwardingStateActive {
+		return statusMsg{text: "Selected port is not being forwarded"}
+	}
+
+	fw := m.forwardManager.GetForwardInfo(selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name, int(selectedRow.PortInfo.Port))
+	if fw == nil || fw.PodName == "" {
+		return statusMsg{text: "The pod of this forward is not known yet"}
+	}
+	if err := clipboard.Write(fw.PodName); err != nil {
+		return statusMsg{text: err.Error()}
+	}
+	return statusMsg{text: fmt.Sprintf("Copied pod name %s to clipboard", fw.PodName)}
+}
+
+// openForwardInBrowser opens the selected active forward in the default browser
+func (m *Model) openForwardInBrowser() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil || selectedRow.ForwardingState != k8s.ForwardingStateActive {
+		return statusMsg{text: "Selected port is not being forwarded"}
+	}
+
+	// Only web schemes make sense in a browser, anything else is tried as http
+	scheme := portScheme(selectedRow.PortInfo)
+	if scheme != "https" {
+		scheme = "http"
+	}
+	url := fmt.Sprintf("%s://localhost:%d", scheme, selectedRow.ForwardingPort)
+	if err := openBrowser(url); err != nil {
+		return errorMsg{err: err}
+	}
+	return statusMsg{text: fmt.Sprintf("Opened %s", url)}
+}
+
+// restartSelectedForward re-establishes the selected active forward on the same
+// local port, through whichever pod currently backs the service. The row shows
+// as pending until the new forward is up.
+func (m *Model) restartSelectedForward() tea.Cmd {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+	namespace, service := selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name
+	port := int(selectedRow.PortInfo.Port)
+
+	if selectedRow.PortInfo.ForwardingState != k8s.ForwardingStateActive {
+		return func() tea.Msg {
+			return statusMsg{text: fmt.Sprintf("%s/%s:%d is not an active forward", namespace, service, port)}
+		}
+	}
+
+	return tea.Batch(
+		func() tea.Msg {
+			return portForwardPendingMsg{namespace: namespace, service: service, port: port}
+		},
+		func() tea.Msg {
+			ctx, cancel := m.forwardContext()
+			defer cancel()
+
+			localPort, err := m.forwardManager.RestartForward(ctx, namespace, service, port)
+			if errors.Is(err, portforward.ErrForwardCancelled) {
+				return nil
+			}
+			if err != nil {
+				if strings.Contains(err.Error(), "already in use") {
+					return portConflictMsg{servicePort: port, remotePort: port}
+				}
+				return portForwardFailedMsg{namespace: namespace, service: service, port: port, reason: err.Error()}
+			}
+			return portForwardStartedMsg{namespace: namespace, service: service, port: port, localPort: localPort}
+		},
+	)
+}
+
+// showKubectlCommand puts the kubectl command equivalent to forwarding the
+// selected row in the status line
+func (m *Model) showKubectlCommand() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+
+	localPort := int(selectedRow.PortInfo.Port)
+	if selectedRow.ForwardingState == k8s.ForwardingStateActive {
+		localPort = selectedRow.ForwardingPort
+	}
+	return statusMsg{text: fmt.Sprintf("kubectl port-forward -n %s svc/%s %d:%d",
+		selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name, localPort, selectedRow.PortInfo.Port)}
 }
 
 func (m *Model) loadClusterInfo() tea.Msg {
 	context := "default"
+	host := ""
 
 	if m.client != nil {
-		// Get server host from the REST config
+		// Prefer the kubeconfig context name the client is using
+		if current := m.client.CurrentContext(); current != "" {
+			context = current
+		}
+
+		// Get server host from the REST config as a secondary detail
 		config := m.client.GetConfig()
 		if config != nil && config.Host != "" {
 			// Extract hostname or IP from the server URL
-			host := config.Host
+			host = config.Host
 			// Remove https:// prefix if present
 			if strings.HasPrefix(host, "https://") {
 				host = host[8:]
@@ -224,11 +880,15 @@ func (m *Model) loadClusterInfo() tea.Msg {
 					host = host[:colonIndex]
 				}
 			}
-			context = host
+			if context == "default" {
+				// In-cluster configs have no context name, fall back to the host
+				context = host
+				host = ""
+			}
 		}
 	}
 
-	return clusterInfoMsg{context: context}
+	return clusterInfoMsg{context: context, host: host}
 }
 
 func (m *Model) loadDeploymentInfo() tea.Msg {
@@ -246,3 +906,200 @@ func (m *Model) loadDeploymentInfo() tea.Msg {
 
 	return deploymentLoadedMsg{deployment: deployment}
 }
+
+func (m *Model) loadPodsInfo() tea.Msg {
+	svc := &m.detailViewService
+	if svc.Name == "" {
+		return podsLoadedMsg{}
+	}
+
+	pods, err := m.client.GetPodsForService(context.Background(), svc.Namespace, svc.Name)
+	return podsLoadedMsg{pods: pods, err: err}
+}
+
+// loadPodMetrics loads the CPU and memory usage of the pods backing the
+// service shown in the detail view
+func (m *Model) loadPodMetrics() tea.Msg {
+	svc := &m.detailViewService
+	if svc.Name == "" {
+		return podMetricsLoadedMsg{}
+	}
+
+	ctx := context.Background()
+	selector, err := m.client.GetServiceSelector(ctx, svc.Namespace, svc.Name)
+	if err != nil || selector == "" {
+		// The pod list reports the error; selectorless services have no pods
+		return podMetricsLoadedMsg{}
+	}
+	metrics, err := m.client.GetPodMetrics(ctx, svc.Namespace, selector)
+	return podMetricsLoadedMsg{metrics: metrics, err: err}
+}
+
+// failureText is the reason shown for a failed forward, pointing at the pod
+// picker when the service has no pod to forward to
+func failureText(err error) string {
+	if errors.Is(err, portforward.ErrNoPod) {
+		return err.Error() + " (F forwards through a pod of your choice)"
+	}
+	return err.Error()
+}
+
+// loadPickerPods loads the pods backing the selected service for the pod
+// picker, or all pods of its namespace when it has none
+func (m *Model) loadPickerPods() tea.Msg {
+	svc := m.table.GetSelected()
+	if svc == nil {
+		return pickerPodsLoadedMsg{}
+	}
+
+	pods, err := m.client.GetPodsForService(context.Background(), svc.Namespace, svc.Name)
+	if err == nil && len(pods) == 0 {
+		// Selectorless or scaled down: offer the namespace's pods, one of
+		// them may serve the port as well, e.g. a proxy
+		pods, err = m.client.GetNamespacePods(context.Background(), svc.Namespace)
+		return pickerPodsLoadedMsg{pods: pods, err: err, namespaceWide: true}
+	}
+	return pickerPodsLoadedMsg{pods: pods, err: err}
+}
+
+// containerChoice is a container of the picked pod declaring the named target
+// port, and the number it gives it
+type containerChoice struct {
+	name string
+	port int32
+}
+
+// containerChoices lists the containers of pod declaring the port called
+// portName, in pod spec order so the first is the one Kubernetes would use
+func containerChoices(pod k8s.PodInfo, portName string) []containerChoice {
+	var choices []containerChoice
+	for _, container := range pod.Containers {
+		if port, ok := container.NamedPorts[portName]; ok {
+			choices = append(choices, containerChoice{name: container.Name, port: port})
+		}
+	}
+	return choices
+}
+
+// forwardToPickedPod leaves the pod picker and forwards the selected port
+// through the picked pod and container
+func (m *Model) forwardToPickedPod(podName, container string) tea.Cmd {
+	m.viewMode = listView
+	m.pickerPod, m.pickerContainers = "", nil
+
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+	return tea.Batch(
+		func() tea.Msg {
+			return portForwardPendingMsg{
+				namespace: selectedRow.ServiceData.Namespace,
+				service:   selectedRow.ServiceData.Name,
+				port:      int(selectedRow.PortInfo.Port),
+			}
+		},
+		m.startPortForwardToPod(podName, container),
+	)
+}
+
+// startPortForwardToPod forwards the selected port through the given pod, to
+// the port of the given container if it is set
+func (m *Model) startPortForwardToPod(podName, container string) tea.Cmd {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+	namespace, service := selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name
+	port := int(selectedRow.PortInfo.Port)
+
+	return func() tea.Msg {
+		// Clean up a previously failed forward so it can be retried
+		m.forwardManager.StopForward(namespace, service, port)
+
+		ctx, cancel := m.forwardContext()
+		defer cancel()
+
+		localPort, err := m.forwardManager.StartForwardToContainer(ctx, namespace, service, podName, container, port, m.preferredLocalPort(namespace, service, port))
+		if errors.Is(err, portforward.ErrForwardCancelled) {
+			return nil
+		}
+		if err != nil {
+			if strings.Contains(err.Error(), "already in use") {
+				return portConflictMsg{servicePort: port, remotePort: port}
+			}
+			return portForwardFailedMsg{
+				namespace: namespace,
+				service:   service,
+				port:      port,
+				reason:    failureText(err),
+			}
+		}
+		return portForwardStartedMsg{namespace: namespace, service: service, port: port, localPort: localPort}
+	}
+}
+
+func (m *Model) loadContexts() tea.Msg {
+	contexts, err := m.client.ListContexts()
+	return contextsLoadedMsg{contexts: contexts, err: err}
+}
+
+// loadIngresses fetches the ingress entries for the ingress view
+func (m *Model) loadIngresses() tea.Msg {
+	ingresses, err := m.client.GetIngresses(context.Background())
+	return ingressesLoadedMsg{ingresses: ingresses, err: err}
+}
+
+// startIngressForward forwards the service behind an ingress entry
+func (m *Model) startIngressForward(ing k8s.IngressInfo) tea.Cmd {
+	return func() tea.Msg {
+		if !ing.HasService() {
+			return statusMsg{text: fmt.Sprintf("%s%s is not backed by a service (%s)", ing.Host, ing.Path, ing.Backend)}
+		}
+
+		ctx, cancel := m.forwardContext()
+		defer cancel()
+
+		port := int(ing.ServicePort)
+		if port == 0 {
+			resolved, err := m.client.ResolveServicePortName(ctx, ing.Namespace, ing.ServiceName, ing.ServicePortName)
+			if err != nil {
+				return statusMsg{text: err.Error()}
+			}
+			port = resolved
+		}
+
+		// Prefer the same local port, but don't block on a conflict here
+		localPort, err := m.startForward(ctx, ing.Namespace, ing.ServiceName, port, m.preferredLocalPort(ing.Namespace, ing.ServiceName, port))
+		if err != nil && strings.Contains(err.Error(), "already in use") {
+			m.forwardManager.StopForward(ing.Namespace, ing.ServiceName, port)
+			localPort, err = m.startForward(ctx, ing.Namespace, ing.ServiceName, port, 0)
+		}
+		if errors.Is(err, portforward.ErrAlreadyForwarding) {
+			return bulkForwardMsg{summary: fmt.Sprintf("%s/%s:%d is already being forwarded", ing.Namespace, ing.ServiceName, port)}
+		}
+		if err != nil {
+			return bulkForwardMsg{summary: fmt.Sprintf("Failed to forward %s/%s:%d: %v", ing.Namespace, ing.ServiceName, port, err)}
+		}
+		return bulkForwardMsg{summary: fmt.Sprintf("Forwarding %s%s via %s/%s:%d → localhost:%d",
+			ing.Host, ing.Path, ing.Namespace, ing.ServiceName, port, localPort)}
+	}
+}
+
+// switchContext stops all forwards and points the client at another context
+func (m *Model) switchContext(name string) tea.Cmd {
+	return func() tea.Msg {
+		if name == m.client.CurrentContext() {
+			return contextSwitchedMsg{context: name}
+		}
+
+		if err := m.client.SwitchContext(name); err != nil {
+			return contextSwitchedMsg{context: name, err: err}
+		}
+
+		// Forwards belong to the previous cluster and can't survive the switch
+		stopped := len(m.forwardManager.GetAllForwards())
+		m.forwardManager.StopAll()
+		return contextSwitchedMsg{context: name, stopped: stopped}
+	}
+}
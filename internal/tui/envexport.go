@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grumpylabs/kpf/internal/clipboard"
+	"github.com/grumpylabs/kpf/internal/k8s"
+)
+
+// DefaultEnvTemplate names the env var exported for a forward, e.g. API_ADDR
+const DefaultEnvTemplate = "{{env .Service}}_ADDR"
+
+// envForward is what an env var name template is executed with
+type envForward struct {
+	Namespace string
+	Service   string
+	PortName  string
+	Port      int // Service port
+	LocalPort int
+}
+
+var nonEnvChars = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// envName uppercases s and replaces what can't be in an env var name with
+// underscores, so "my-api" becomes "MY_API"
+func envName(s string) string {
+	return nonEnvChars.ReplaceAllString(strings.ToUpper(s), "_")
+}
+
+// ParseEnvTemplate parses the template env var names are derived from. The
+// forward's .Namespace, .Service, .PortName, .Port and .LocalPort can be used,
+// and the env and upper functions.
+func ParseEnvTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("env").Funcs(template.FuncMap{
+		"env":   envName,
+		"upper": strings.ToUpper,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid env template %q: %w", text, err)
+	}
+	return tmpl, nil
+}
+
+// copyEnvExports copies an export line for every active forward to the
+// clipboard, pointing an env var at its local address
+func (m *Model) copyEnvExports() tea.Msg {
+	tmpl := m.options.EnvTemplate
+	if tmpl == nil {
+		tmpl, _ = ParseEnvTemplate(DefaultEnvTemplate)
+	}
+
+	var lines []string
+	seen := make(map[string]bool)
+	for _, fw := range m.sortedForwards() {
+		if fw.ForwardingState != k8s.ForwardingStateActive {
+			continue
+		}
+
+		var name strings.Builder
+		err := tmpl.Execute(&name, envForward{
+			Namespace: fw.Namespace,
+			Service:   fw.Service,
+			PortName:  m.servicePortName(fw.Namespace, fw.Service, fw.RemotePort),
+			Port:      fw.RemotePort,
+			LocalPort: fw.LocalPort,
+		})
+		if err != nil {
+			return statusMsg{text: fmt.Sprintf("Env template failed: %v", err)}
+		}
+
+		// Several ports of a service would otherwise share a name
+		key := name.String()
+		if seen[key] {
+			key = fmt.Sprintf("%s_%d", key, fw.RemotePort)
+		}
+		seen[key] = true
+		lines = append(lines, fmt.Sprintf("export %s=localhost:%d", key, fw.LocalPort))
+	}
+
+	if len(lines) == 0 {
+		return statusMsg{text: "No active forwards to export"}
+	}
+	if err := clipboard.Write(strings.Join(lines, "\n") + "\n"); err != nil {
+		return statusMsg{text: err.Error()}
+	}
+	return statusMsg{text: fmt.Sprintf("Copied %d env exports to clipboard", len(lines))}
+}
+
+// servicePortName returns the name of a loaded service's port, or "" if the
+// port is unnamed or the service isn't loaded
+func (m *Model) servicePortName(namespace, service string, port int) string {
+	for _, svc := range m.table.services {
+		if svc.Namespace != namespace || svc.Name != service {
+			continue
+		}
+		for _, p := range svc.Ports {
+			if int(p.Port) == port {
+				return p.Name
+			}
+		}
+	}
+	return ""
+}
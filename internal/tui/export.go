@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// exportRow is one table row as written by the export
+type exportRow struct {
+	Namespace       string `json:"namespace"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	PortName        string `json:"portName,omitempty"`
+	Port            int32  `json:"port"`
+	Protocol        string `json:"protocol,omitempty"`
+	ClusterIP       string `json:"clusterIP"`
+	ExternalIP      string `json:"externalIP"`
+	ForwardingState string `json:"forwardingState"`
+	LocalPort       int    `json:"localPort,omitempty"`
+}
+
+// exportTable writes the rows currently shown (after filters) to a timestamped
+// JSON file, plus a CSV file when enabled. The rows are copied up front so the
+// write can run in the background.
+func (m *Model) exportTable() tea.Cmd {
+	activeRows := m.table.getActiveRows()
+	rows := make([]exportRow, 0, len(activeRows))
+	for _, row := range activeRows {
+		rows = append(rows, exportRow{
+			Namespace:       row.Namespace,
+			Name:            row.Name,
+			Type:            row.Type,
+			PortName:        row.PortName,
+			Port:            row.Port,
+			Protocol:        row.Protocol,
+			ClusterIP:       row.ClusterIP,
+			ExternalIP:      row.ExternalIP,
+			ForwardingState: row.ForwardingState.String(),
+			LocalPort:       row.ForwardingPort,
+		})
+	}
+
+	dir := m.options.ExportDir
+	if dir == "" {
+		dir = "."
+	}
+	withCSV := m.options.ExportCSV
+
+	return func() tea.Msg {
+		base := filepath.Join(dir, "kpf-export-"+time.Now().Format("20060102-150405"))
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return statusMsg{text: fmt.Sprintf("Export failed: %v", err)}
+		}
+
+		data, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return statusMsg{text: fmt.Sprintf("Export failed: %v", err)}
+		}
+		if err := os.WriteFile(base+".json", data, 0o644); err != nil {
+			return statusMsg{text: fmt.Sprintf("Export failed: %v", err)}
+		}
+		written := base + ".json"
+
+		if withCSV {
+			if err := writeExportCSV(base+".csv", rows); err != nil {
+				return statusMsg{text: fmt.Sprintf("Exported %d rows to %s, CSV failed: %v", len(rows), written, err)}
+			}
+			written += " and " + base + ".csv"
+		}
+
+		return statusMsg{text: fmt.Sprintf("Exported %d rows to %s", len(rows), written)}
+	}
+}
+
+// writeExportCSV writes rows to path as CSV with a header line
+func writeExportCSV(path string, rows []exportRow) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"namespace", "name", "type", "portName", "port", "protocol", "clusterIP", "externalIP", "forwardingState", "localPort"})
+	for _, row := range rows {
+		localPort := ""
+		if row.LocalPort > 0 {
+			localPort = strconv.Itoa(row.LocalPort)
+		}
+		w.Write([]string{row.Namespace, row.Name, row.Type, row.PortName, strconv.Itoa(int(row.Port)), row.Protocol,
+			row.ClusterIP, row.ExternalIP, row.ForwardingState, localPort})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,257 @@
+package tui
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+const (
+	// logTailLines is how much history is fetched when a log stream opens
+	logTailLines = 100
+
+	// logBufferLines caps the log lines kept in memory
+	logBufferLines = 1000
+)
+
+// logsPodMsg carries the pod chosen for a forward and logs action
+type logsPodMsg struct {
+	namespace string
+	service   string
+	port      int
+	pod       string
+	err       error
+}
+
+// logsStartedMsg carries the line channel of a newly opened log stream
+type logsStartedMsg struct {
+	lines  <-chan string
+	cancel context.CancelFunc
+	err    error
+}
+
+// logLineMsg delivers one log line from the stream it was read from
+type logLineMsg struct {
+	lines <-chan string
+	line  string
+}
+
+// logsEndedMsg reports that a log stream was closed by the cluster
+type logsEndedMsg struct {
+	lines <-chan string
+}
+
+// startForwardWithLogs picks a pod for the selected port so the forward and
+// the log stream are pinned to the same replica
+func (m *Model) startForwardWithLogs() tea.Msg {
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+	namespace, service := selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name
+	port := int(selectedRow.PortInfo.Port)
+
+	if selectedRow.ServiceData.Type == "ExternalName" {
+		return logsPodMsg{namespace: namespace, service: service, port: port, err: errors.New(externalNameReason(&selectedRow.ServiceData))}
+	}
+
+	// An active forward already has a pod, show the logs of that one
+	if fw := m.forwardManager.GetForwardInfo(namespace, service, port); fw != nil && fw.ForwardingState == k8s.ForwardingStateActive && fw.PodName != "" {
+		return logsPodMsg{namespace: namespace, service: service, port: port, pod: fw.PodName}
+	}
+
+	pods, err := m.client.GetPodsForService(context.Background(), namespace, service)
+	if err != nil {
+		return logsPodMsg{namespace: namespace, service: service, port: port, err: err}
+	}
+	pod := pickLogPod(pods)
+	if pod == "" {
+		return logsPodMsg{namespace: namespace, service: service, port: port, err: fmt.Errorf("no pods found for service %s", service)}
+	}
+	return logsPodMsg{namespace: namespace, service: service, port: port, pod: pod}
+}
+
+// pickLogPod prefers a ready pod, then a running one, then any pod
+func pickLogPod(pods []k8s.PodInfo) string {
+	for _, pod := range pods {
+		if pod.Ready {
+			return pod.Name
+		}
+	}
+	for _, pod := range pods {
+		if pod.Phase == "Running" {
+			return pod.Name
+		}
+	}
+	if len(pods) > 0 {
+		return pods[0].Name
+	}
+	return ""
+}
+
+// forwardForLogs forwards the port through the pod the logs are streamed from.
+// A local port conflict falls back to an automatic port instead of prompting,
+// since the logs view is already showing.
+func (m *Model) forwardForLogs(namespace, service string, port int, podName string) tea.Cmd {
+	return func() tea.Msg {
+		// Clean up a previously failed forward so it can be retried
+		if fwInfo := m.forwardManager.GetForwardInfo(namespace, service, port); fwInfo != nil && fwInfo.ForwardingState == k8s.ForwardingStateFailed {
+			m.forwardManager.StopForward(namespace, service, port)
+		}
+
+		ctx, cancel := m.forwardContext()
+		defer cancel()
+
+		localPort, err := m.startForwardToPod(ctx, namespace, service, podName, port, m.preferredLocalPort(namespace, service, port))
+		if err != nil && strings.Contains(err.Error(), "already in use") {
+			m.forwardManager.StopForward(namespace, service, port)
+			localPort, err = m.startForwardToPod(ctx, namespace, service, podName, port, 0)
+		}
+		if errors.Is(err, portforward.ErrForwardCancelled) {
+			return nil
+		}
+		if errors.Is(err, portforward.ErrAlreadyForwarding) {
+			return statusMsg{text: fmt.Sprintf("%s/%s:%d is already being forwarded", namespace, service, port)}
+		}
+		if err != nil {
+			return portForwardFailedMsg{namespace: namespace, service: service, port: port, reason: err.Error()}
+		}
+		return portForwardStartedMsg{namespace: namespace, service: service, port: port, localPort: localPort}
+	}
+}
+
+// openLogStream starts following the logs of a pod
+func (m *Model) openLogStream(namespace, podName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := m.client.StreamPodLogs(ctx, namespace, podName, logTailLines)
+		if err != nil {
+			cancel()
+			return logsStartedMsg{err: err}
+		}
+
+		lines := make(chan string, 64)
+		go func() {
+			defer close(lines)
+			defer stream.Close()
+
+			scanner := bufio.NewScanner(stream)
+			scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				select {
+				case lines <- scanner.Text():
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		return logsStartedMsg{lines: lines, cancel: cancel}
+	}
+}
+
+// waitForLogLine blocks until the log stream delivers a line or ends
+func waitForLogLine(lines <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lines
+		if !ok {
+			return logsEndedMsg{lines: lines}
+		}
+		return logLineMsg{lines: lines, line: line}
+	}
+}
+
+// appendLogLine adds a line to the log buffer, dropping the oldest lines
+// once it is full
+func (m *Model) appendLogLine(line string) {
+	m.logLines = append(m.logLines, line)
+	if len(m.logLines) > logBufferLines {
+		m.logLines = m.logLines[len(m.logLines)-logBufferLines:]
+	}
+	if m.logScroll > 0 {
+		// Keep the lines being read in place while new ones arrive
+		m.logScroll++
+	}
+}
+
+// closeLogs stops the log stream, if any
+func (m *Model) closeLogs() {
+	if m.stopLogs != nil {
+		m.stopLogs()
+		m.stopLogs = nil
+	}
+	m.logStream = nil
+}
+
+// logsForward returns the forward the logs view belongs to
+func (m *Model) logsForward() *portforward.ForwardInfo {
+	return m.forwardManager.GetForwardInfo(m.logsNamespace, m.logsService, m.logsPort)
+}
+
+// scrollLogs moves the logs view delta lines back (positive) or forward
+func (m *Model) scrollLogs(delta int) {
+	m.logScroll += delta
+	if maxScroll := len(m.logLines) - 1; m.logScroll > maxScroll {
+		m.logScroll = maxScroll
+	}
+	if m.logScroll < 0 {
+		m.logScroll = 0
+	}
+}
+
+func (m *Model) renderLogsView() string {
+	header := m.renderAdminHeader()
+	sectionHeaders := m.renderSectionHeaders()
+
+	title := fmt.Sprintf("Logs: %s/%s:%d", m.logsNamespace, m.logsService, m.logsPort)
+	if m.logsPod != "" {
+		title += " via pod/" + m.logsPod
+	}
+	if fw := m.logsForward(); fw != nil {
+		if fw.ForwardingState == k8s.ForwardingStateActive {
+			title += fmt.Sprintf(" → localhost:%d", fw.LocalPort)
+		} else {
+			title += " (" + fw.ForwardingState.String() + ")"
+		}
+	}
+	content := header + sectionHeaders + adminTableHeaderStyle.Width(m.width).Render(title) + "\n\n"
+
+	// Lines left for the log after the header, title and footer
+	available := m.height - strings.Count(content, "\n") - 3
+	if available < 1 {
+		available = 1
+	}
+
+	switch {
+	case m.logsErr != nil:
+		content += errorStyle.Render(m.logsErr.Error()) + "\n"
+	case len(m.logLines) == 0 && m.logStream != nil:
+		content += "Waiting for log output...\n"
+	case len(m.logLines) == 0:
+		content += "Opening log stream...\n"
+	default:
+		end := len(m.logLines) - m.logScroll
+		start := end - available
+		if start < 0 {
+			start = 0
+		}
+		for _, line := range m.logLines[start:end] {
+			content += truncateString(line, m.width) + "\n"
+		}
+		if m.logsEnded {
+			content += inactiveStyle.Render("-- log stream ended --") + "\n"
+		}
+	}
+
+	footerText := "↑↓/jk:scroll pgup/pgdn:page end:follow x:stop-forward esc:close"
+	if m.logScroll > 0 {
+		footerText = fmt.Sprintf("[%d lines back] ", m.logScroll) + footerText
+	}
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
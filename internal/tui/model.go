@@ -1,12 +1,15 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync/atomic"
+	"text/template"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -14,6 +17,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/grumpylabs/kpf/internal/k8s"
 	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/mattn/go-runewidth"
 )
 
 type viewMode int
@@ -25,23 +29,33 @@ const (
 	portInputView
 	errorModalView
 	filterView
+	podPickerView
+	contextView
+	forwardsView
+	confirmStopAllView
+	ingressView
+	logsView
+	paletteView
+	confirmQuitView
 )
 
 // Model represents the main TUI model
 type Model struct {
-	client         *k8s.Client
+	client         ServiceLister
 	table          *ServiceTable
 	viewMode       viewMode
 	ready          bool
 	width          int
 	height         int
 	keys           keyMap
-	forwardManager *portforward.Manager
+	forwardManager portforward.Forwarder
+	forwardStore   portforward.StateStore // nil if forwards aren't persisted
 	err            error
 	statusMessage  string
 	kubeconfig     string
 	namespace      string
 	context        string
+	clusterHost    string
 	lastRefresh    time.Time
 	sortField      string // "namespace", "name", "status"
 	sortAscending  bool
@@ -59,19 +73,118 @@ type Model struct {
 	// Detail view state
 	deploymentInfo   *k8s.DeploymentInfo
 	detailViewService k8s.ServiceInfo // Store a copy of the service we're viewing details for
+	podInfos          []k8s.PodInfo
+	podsErr           error
+	podsLoaded        bool
+	podMetrics        map[string]k8s.PodMetrics
+	podMetricsErr     error
+	detailOffset      int // Index of the first port shown in the detail view
+	detailPageSize    int // Number of ports that fit in the detail view
 	
 	// Active forwards counter (atomic for thread safety)
 	activeForwardsCount int64
+
+	// Uptime and traffic of the active forwards, refreshed with the counter
+	forwardSummary portforward.Summary
+
+	// Whether a pendingTick is scheduled
+	pendingTicking bool
+
+	// When the first service list was requested, and why it failed if it
+	// did; the list view shows a loading state until one arrives
+	loadingSince time.Time
+	loadErr      error
 	
 	// Version info
 	commitHash     string
+
+	// File active forwards are persisted to between sessions
+	statePath string
+
+	// Local ports last used per service port, remembered between sessions
+	localPortsPath string
+	lastLocalPorts portforward.LocalPorts
+
+	// File the sort order is remembered in between sessions
+	preferencesPath string
+
+	// Local ports last tried with +/- per service port, so nudges add up
+	nudgedPorts map[string]int
+
+	// Forwards to keep running in a background process after quitting
+	detachedForwards []portforward.SavedForward
+
+	// Lifecycle events of the forward manager, so rows update as soon as
+	// a tunnel drops or reconnects
+	forwardEvents <-chan portforward.Event
+
+	// Service watch state
+	options      Options
+	serviceWatch <-chan []k8s.ServiceInfo
+	stopWatch    context.CancelFunc
+
+	// Auto-refresh and watch updates are held back while paused, the latest
+	// watched list is applied on resume
+	refreshPaused  bool
+	pausedServices []k8s.ServiceInfo
 	
+	// Pod picker state
+	pickerPods    []k8s.PodInfo
+	pickerErr     error
+	pickerLoaded  bool
+	pickerIndex   int
+	pickerNamespaceWide bool
+
+	// Set once a pod is picked whose containers declare the named target
+	// port differently, to pick one of them next
+	pickerPod            string
+	pickerContainers     []containerChoice
+	pickerContainerIndex int
+
+	// Context switcher state
+	contexts       []string
+	contextsErr    error
+	contextsLoaded bool
+	contextIndex   int
+
+	// Forwards overview state
+	forwardsIndex int
+
+	// Ingress view state
+	ingresses       []k8s.IngressInfo
+	ingressesErr    error
+	ingressesLoaded bool
+	ingressIndex    int
+
+	// Logs view state
+	logsNamespace string
+	logsService   string
+	logsPort      int
+	logsPod       string
+	logsErr       error
+	logsEnded     bool
+	logsForwarded bool // Whether the forward was seen since the logs opened
+	logLines      []string
+	logScroll     int // Lines scrolled back from the tail
+	logStream     <-chan string
+	stopLogs      context.CancelFunc
+
 	// Filter state
 	filterInput       string
-	filterType        string // "status", "type", "name", "protocol", or empty for all
+	filterType        string // "status", "type", "name", "namespace", "protocol", "forwardable", or empty for all
 	activeFilters     map[string]string // Store active filters
 	filterSuggestions []string // Available suggestions for current filter type
 	filterCompletion  int      // Index of current completion suggestion (-1 = none)
+
+	// Command palette state
+	paletteInput      string
+	paletteMatches    []string // Completions of the typed input, nil until tab is pressed
+	paletteCompletion int      // Index of the completion shown (-1 = none)
+	paletteErr        error
+
+	// Quick search state, a name filter typed inline in the list view
+	searching   bool
+	searchInput string
 }
 
 type keyMap struct {
@@ -80,7 +193,27 @@ type keyMap struct {
 	Enter    key.Binding
 	Back     key.Binding
 	Forward  key.Binding
+	ForwardAll key.Binding
+	ForwardToPod key.Binding
+	ForwardLogs  key.Binding
+	Copy     key.Binding
+	CopyEnv  key.Binding
+	CopyPod  key.Binding
+	Open     key.Binding
+	Kubectl  key.Binding
+	Restart  key.Binding
+	PortUp   key.Binding
+	PortDown key.Binding
+	Layout   key.Binding
+	Collapse key.Binding
+	Group    key.Binding
+	Search   key.Binding
+	Palette  key.Binding
+	NextActive key.Binding
+	PrevActive key.Binding
 	Refresh  key.Binding
+	ForceRefresh key.Binding
+	PauseRefresh key.Binding
 	Quit     key.Binding
 	PageUp   key.Binding
 	PageDown key.Binding
@@ -92,8 +225,17 @@ type keyMap struct {
 	SortStatus    key.Binding
 	SortPorts     key.Binding
 	SortLocalPort key.Binding
+	SortAge       key.Binding
+	SortStarted   key.Binding
+	SortFailed    key.Binding
 	Help         key.Binding
 	Filter       key.Binding
+	Context      key.Binding
+	Forwards     key.Binding
+	StopForward  key.Binding
+	StopAll      key.Binding
+	Ingresses    key.Binding
+	Export       key.Binding
 }
 
 var keys = keyMap{
@@ -134,13 +276,93 @@ var keys = keyMap{
 		key.WithHelp("d", "details"),
 	),
 	Forward: key.NewBinding(
-		key.WithKeys("f", "F"),
+		key.WithKeys("f"),
 		key.WithHelp("f", "toggle forward"),
 	),
+	ForwardToPod: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "forward to a chosen pod"),
+	),
+	ForwardLogs: key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("l", "forward and watch logs"),
+	),
+	ForwardAll: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "toggle forward for all ports"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "copy forward address"),
+	),
+	CopyEnv: key.NewBinding(
+		key.WithKeys("Y"),
+		key.WithHelp("Y", "copy env exports of all forwards"),
+	),
+	CopyPod: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "copy pod name of forward"),
+	),
+	Open: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "open in browser"),
+	),
+	NextActive: key.NewBinding(
+		key.WithKeys("n", "tab"),
+		key.WithHelp("n/tab", "next active forward"),
+	),
+	PrevActive: key.NewBinding(
+		key.WithKeys("p", "shift+tab"),
+		key.WithHelp("p/shift+tab", "previous active forward"),
+	),
+	Search: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "quick search by name"),
+	),
+	Palette: key.NewBinding(
+		key.WithKeys(":"),
+		key.WithHelp(":", "forward by typing namespace/service:port"),
+	),
+	Layout: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "toggle compact layout"),
+	),
+	Collapse: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "collapse services to one row"),
+	),
+	Group: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "toggle grouping by label"),
+	),
+	Kubectl: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "show kubectl command"),
+	),
+	Restart: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "restart forward on a fresh pod"),
+	),
+	PortUp: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "retry failed forward on the next local port"),
+	),
+	PortDown: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "retry failed forward on the previous local port"),
+	),
 	Refresh: key.NewBinding(
-		key.WithKeys("r", "ctrl+r"),
+		key.WithKeys("r"),
 		key.WithHelp("r", "refresh"),
 	),
+	ForceRefresh: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "refresh, bypassing the cache"),
+	),
+	PauseRefresh: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "pause/resume auto-refresh"),
+	),
 	Quit: key.NewBinding(
 		key.WithKeys("ctrl+c"),
 		key.WithHelp("ctrl+c", "quit"),
@@ -165,6 +387,18 @@ var keys = keyMap{
 		key.WithKeys("L"),
 		key.WithHelp("L", "sort by local port"),
 	),
+	SortAge: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "sort by age"),
+	),
+	SortStarted: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "sort by forward start"),
+	),
+	SortFailed: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "sort failed forwards first"),
+	),
 	Help: key.NewBinding(
 		key.WithKeys("?", "h"),
 		key.WithHelp("?/h", "help"),
@@ -173,10 +407,184 @@ var keys = keyMap{
 		key.WithKeys("/"),
 		key.WithHelp("/", "filter"),
 	),
+	Context: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "switch context"),
+	),
+	Forwards: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "view forwards"),
+	),
+	StopForward: key.NewBinding(
+		key.WithKeys("x", "enter"),
+		key.WithHelp("x/enter", "stop forward"),
+	),
+	StopAll: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "stop all forwards"),
+	),
+	Ingresses: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "view ingresses"),
+	),
+	Export: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "export table"),
+	),
 }
 
-// NewModel creates a new TUI model
-func NewModel(client *k8s.Client, kubeconfigArg string, commitHash string) *Model {
+// Options holds optional TUI behavior configured from the command line
+type Options struct {
+	// Watch keeps the service list up to date using the Kubernetes watch API
+	Watch bool
+
+	// RefreshInterval reloads the service list this often, 0 disables it
+	RefreshInterval time.Duration
+
+	// ForwardTimeout is how long the forwarder waits for a forward to become
+	// ready, portforward.DefaultReadyTimeout if 0
+	ForwardTimeout time.Duration
+
+	// KeyBindings remaps actions to keys, e.g. "forward" -> ["f", "space"]
+	KeyBindings map[string][]string
+
+	// Forwards are started as soon as the TUI launches
+	Forwards []portforward.SavedForward
+
+	// LocalPorts pins the local port used for specific service ports
+	LocalPorts portforward.LocalPorts
+
+	// ExportDir is where exports of the table are written, "." by default
+	ExportDir string
+
+	// ExportCSV writes a CSV file next to the JSON export
+	ExportCSV bool
+
+	// ColorByNamespace gives each namespace its own color in the table
+	ColorByNamespace bool
+
+	// GroupByLabel groups the table by the value of this service label,
+	// under a header per group. No grouping if empty.
+	GroupByLabel string
+
+	// EnvTemplate names the env vars of the env exports copied with Y,
+	// DefaultEnvTemplate if nil
+	EnvTemplate *template.Template
+
+	// AllowPrivilegedPorts lets local ports below 1024 be typed in, for
+	// users running as root
+	AllowPrivilegedPorts bool
+
+	// Detach quits without asking, leaving the active forwards to a
+	// background process
+	Detach bool
+
+	// ReadOnly lets services be browsed but not forwarded. Saved forwards
+	// are neither restored nor overwritten.
+	ReadOnly bool
+
+	// StartupWarning is shown in the status line on launch, e.g. why the
+	// forwards fall back to localhost
+	StartupWarning string
+}
+
+// bindings returns the remappable actions of a keyMap by their config name
+func (k *keyMap) bindings() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"up":              &k.Up,
+		"down":            &k.Down,
+		"enter":           &k.Enter,
+		"back":            &k.Back,
+		"detail":          &k.Detail,
+		"forward":         &k.Forward,
+		"forward-to-pod":  &k.ForwardToPod,
+		"forward-logs":    &k.ForwardLogs,
+		"forward-all":     &k.ForwardAll,
+		"copy":            &k.Copy,
+		"copy-env":        &k.CopyEnv,
+		"copy-pod":        &k.CopyPod,
+		"open":            &k.Open,
+		"kubectl":         &k.Kubectl,
+		"restart-forward": &k.Restart,
+		"port-up":         &k.PortUp,
+		"port-down":       &k.PortDown,
+		"layout":          &k.Layout,
+		"collapse":        &k.Collapse,
+		"group":           &k.Group,
+		"search":          &k.Search,
+		"palette":         &k.Palette,
+		"next-active":     &k.NextActive,
+		"prev-active":     &k.PrevActive,
+		"refresh":         &k.Refresh,
+		"force-refresh":   &k.ForceRefresh,
+		"pause-refresh":   &k.PauseRefresh,
+		"quit":            &k.Quit,
+		"page-up":         &k.PageUp,
+		"page-down":       &k.PageDown,
+		"home":            &k.Home,
+		"end":             &k.End,
+		"sort-namespace":  &k.SortNamespace,
+		"sort-name":       &k.SortName,
+		"sort-status":     &k.SortStatus,
+		"sort-ports":      &k.SortPorts,
+		"sort-local-port": &k.SortLocalPort,
+		"sort-age":        &k.SortAge,
+		"sort-started":    &k.SortStarted,
+		"sort-failed":     &k.SortFailed,
+		"help":            &k.Help,
+		"filter":          &k.Filter,
+		"context":         &k.Context,
+		"forwards":        &k.Forwards,
+		"stop-forward":    &k.StopForward,
+		"stop-all":        &k.StopAll,
+		"ingresses":       &k.Ingresses,
+		"export":          &k.Export,
+	}
+}
+
+// buildKeyMap applies overrides on top of the default keys. Invalid entries
+// keep their default binding and are reported back as warnings.
+func buildKeyMap(overrides map[string][]string) (keyMap, []string) {
+	km := keys
+	bindings := km.bindings()
+
+	var warnings []string
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		binding, ok := bindings[strings.ToLower(name)]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("unknown action %q", name))
+			continue
+		}
+
+		var boundKeys []string
+		for _, k := range overrides[name] {
+			if k = strings.TrimSpace(k); k != "" {
+				boundKeys = append(boundKeys, k)
+			}
+		}
+		if len(boundKeys) == 0 {
+			warnings = append(warnings, fmt.Sprintf("no keys given for %q", name))
+			continue
+		}
+
+		*binding = key.NewBinding(
+			key.WithKeys(boundKeys...),
+			key.WithHelp(strings.Join(boundKeys, "/"), binding.Help().Desc),
+		)
+	}
+
+	return km, warnings
+}
+
+// NewModel creates a new TUI model. An empty namespace shows services from
+// all namespaces.
+func NewModel(client ServiceLister, forwardManager portforward.Forwarder, kubeconfigArg string, namespace string, commitHash string, options Options) *Model {
 	// Use the kubeconfig path passed from CLI args, with fallback
 	kubeconfig := kubeconfigArg
 	if kubeconfig == "" {
@@ -189,41 +597,98 @@ func NewModel(client *k8s.Client, kubeconfigArg string, commitHash string) *Mode
 		}
 	}
 
-	// Get context from kubeconfig (simplified - you might want to parse the actual file)
-	context := "kubernetes-cluster"
+	// Get context from kubeconfig, loadClusterInfo fills in the details
+	context := ""
+	if client != nil {
+		context = client.CurrentContext()
+	}
 
-	// Get namespace
-	namespace := os.Getenv("KPF_NAMESPACE")
 	if namespace == "" {
 		namespace = "all"
 	}
 
 	table := NewServiceTable()
+	table.SetColorByNamespace(options.ColorByNamespace)
+	table.SetGroupLabel(options.GroupByLabel)
+	table.SetLocalPorts(options.LocalPorts)
+
+	// Best effort, a broken file just means no remembered ports
+	localPortsPath := portforward.DefaultLocalPortsPath()
+	lastLocalPorts := portforward.LocalPorts{}
+	if localPortsPath != "" {
+		if ports, err := portforward.LoadLocalPorts(localPortsPath); err == nil {
+			lastLocalPorts = ports
+		}
+	}
+
+	// Best effort as well, the default sort is used if the file is broken
+	preferencesPath := defaultPreferencesPath()
+	prefs, _ := loadPreferences(preferencesPath)
+
+	keyBindings, warnings := buildKeyMap(options.KeyBindings)
+	statusMessage := options.StartupWarning
+	if len(warnings) > 0 {
+		statusMessage = "Ignoring invalid keybindings: " + strings.Join(warnings, ", ")
+		if options.StartupWarning != "" {
+			statusMessage = options.StartupWarning + "; " + statusMessage
+		}
+	}
+
+	statePath := portforward.DefaultStatePath()
+	forwardStore, _ := forwardManager.(portforward.StateStore)
+	if options.ReadOnly || forwardStore == nil {
+		statePath, localPortsPath = "", ""
+	}
 
 	return &Model{
 		client:         client,
 		table:          table,
 		viewMode:       listView,
-		keys:           keys,
-		forwardManager: portforward.NewManager(client),
+		keys:           keyBindings,
+		statusMessage:  statusMessage,
+		forwardManager: forwardManager,
+		forwardStore:   forwardStore,
 		kubeconfig:     kubeconfig,
 		namespace:      namespace,
 		context:        context,
-		lastRefresh:    time.Now(),
-		sortField:      "namespace",
-		sortAscending:  true,
+		sortField:      prefs.SortField,
+		sortAscending:  prefs.SortAscending,
 		commitHash:       commitHash,
+		statePath:        statePath,
+		localPortsPath:   localPortsPath,
+		lastLocalPorts:   lastLocalPorts,
+		preferencesPath:  preferencesPath,
+		options:          options,
 		activeFilters:    make(map[string]string),
+		nudgedPorts:      make(map[string]int),
 		filterCompletion: -1,
 	}
 }
 
 // Init initializes the model
 func (m *Model) Init() tea.Cmd {
-	return tea.Batch(
+	m.loadingSince = time.Now()
+	cmds := []tea.Cmd{
 		m.loadServices,
 		m.loadClusterInfo,
-	)
+		m.restoreForwards,
+		forwardSyncTick(),
+		loadingTick(),
+	}
+	if events, ok := m.forwardManager.(portforward.EventSource); ok {
+		m.forwardEvents, _ = events.Subscribe()
+		cmds = append(cmds, m.waitForForwardEvent())
+	}
+	if len(m.options.Forwards) > 0 && m.forwardStore != nil {
+		cmds = append(cmds, m.startStartupForwards)
+	}
+	if m.options.Watch {
+		cmds = append(cmds, m.startServiceWatch)
+	}
+	if m.options.RefreshInterval > 0 {
+		cmds = append(cmds, autoRefreshTick(m.options.RefreshInterval))
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles TUI updates
@@ -258,18 +723,67 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.ready = true
 		return m, nil
 
+	case ShutdownMsg:
+		m.shutdown()
+		return m, tea.Quit
+
+	case tea.MouseMsg:
+		if m.viewMode != listView {
+			return m, nil
+		}
+		switch {
+		case msg.Button == tea.MouseButtonWheelUp:
+			m.table.MoveUp()
+		case msg.Button == tea.MouseButtonWheelDown:
+			m.table.MoveDown()
+		case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+			// Rows start below the headers, the table header and a blank line
+			tableTop := strings.Count(m.renderAdminHeader()+m.renderSectionHeaders(), "\n")
+			index, ok := m.table.RowIndexAt(msg.Y - tableTop)
+			if !ok {
+				return m, nil
+			}
+			m.table.SetSelected(index)
+			// The status dot is the first column
+			if msg.X <= 1 && !m.options.ReadOnly {
+				return m, m.toggleSelectedForward()
+			}
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		// Ctrl+C should always quit from anywhere
 		if key.Matches(msg, m.keys.Quit) {
-			m.forwardManager.StopAll()
+			m.shutdown()
 			return m, tea.Quit
 		}
 		
+		if m.viewMode == listView && m.searching {
+			return m, m.updateSearch(msg)
+		}
+
+		if m.options.ReadOnly && m.startsForward(msg) {
+			m.statusMessage = "Forwarding is disabled in read-only mode"
+			return m, clearStatusAfter(m.statusMessage)
+		}
+
 		if m.viewMode == listView {
 			switch {
+			case msg.Type == tea.KeyEsc && m.selectedForwardPending():
+				// esc on a pending forward cancels it instead of quitting
+				return m, m.cancelPendingForward
+
 			case msg.String() == "q" || msg.Type == tea.KeyEsc:
-				// q and esc quit from main menu
-				m.forwardManager.StopAll()
+				// q and esc quit from main menu, after confirming if
+				// that would stop forwards
+				if atomic.LoadInt64(&m.activeForwardsCount) > 0 {
+					if m.options.Detach {
+						return m, m.quitDetached()
+					}
+					m.viewMode = confirmQuitView
+					return m, nil
+				}
+				m.shutdown()
 				return m, tea.Quit
 
 			case key.Matches(msg, m.keys.Up):
@@ -302,104 +816,217 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 			case key.Matches(msg, m.keys.Enter):
+				// Collapsed services expand to their ports first
+				if m.table.ExpandSelected() {
+					return m, nil
+				}
+
+				// On a failed forward, show why it failed in full; d still
+				// opens the details
+				if row := m.table.GetSelectedRow(); row != nil && row.PortInfo != nil &&
+					row.PortInfo.ForwardingState == k8s.ForwardingStateFailed && row.PortInfo.FailureReason != "" {
+					m.previousView = listView
+					m.viewMode = errorModalView
+					m.errorMessage = fmt.Sprintf("Forwarding %s/%s:%d failed: %s",
+						row.ServiceData.Namespace, row.ServiceData.Name, row.PortInfo.Port, row.PortInfo.FailureReason)
+					return m, nil
+				}
+
 				selectedService := m.table.GetSelected()
 				if selectedService != nil {
 					m.detailViewService = *selectedService // Store a copy of the service we're viewing
 					m.viewMode = detailView
+					m.detailOffset = 0
 					m.deploymentInfo = nil // Clear previous deployment info
-					return m, m.loadDeploymentInfo
+					m.podInfos, m.podsErr, m.podsLoaded = nil, nil, false
+					m.podMetrics, m.podMetricsErr = nil, nil
+					return m, tea.Batch(m.loadDeploymentInfo, m.loadPodsInfo, m.loadPodMetrics)
 				}
 
 			case key.Matches(msg, m.keys.Forward):
-				selected := m.table.GetSelected()
+				return m, m.toggleSelectedForward()
+
+			case key.Matches(msg, m.keys.ForwardToPod):
 				selectedRow := m.table.GetSelectedRow()
-				if selected != nil && selectedRow != nil && selectedRow.PortInfo != nil {
-					// For inactive ports, send pending message immediately, then start port forward
-					if selectedRow.PortInfo.ForwardingState == k8s.ForwardingStateInactive {
-						return m, tea.Batch(
-							func() tea.Msg {
-								return portForwardPendingMsg{
-									namespace: selectedRow.ServiceData.Namespace,
-									service:   selectedRow.ServiceData.Name,
-									port:      int(selectedRow.PortInfo.Port),
-								}
-							},
-							m.startPortForward,
-						)
-					} else {
-						// For active or failed ports, just start port forward (will toggle or retry)
-						return m, m.startPortForward
-					}
+				if selectedRow != nil && selectedRow.PortInfo != nil &&
+					selectedRow.PortInfo.ForwardingState != k8s.ForwardingStateActive &&
+					selectedRow.PortInfo.ForwardingState != k8s.ForwardingStatePending {
+					m.viewMode = podPickerView
+					m.pickerPods, m.pickerErr, m.pickerLoaded = nil, nil, false
+					m.pickerIndex = 0
+					m.pickerPod, m.pickerContainers = "", nil
+					return m, m.loadPickerPods
 				}
 
+			case key.Matches(msg, m.keys.ForwardLogs):
+				selectedRow := m.table.GetSelectedRow()
+				if selectedRow != nil && selectedRow.PortInfo != nil {
+					m.closeLogs()
+					m.viewMode = logsView
+					m.logsNamespace = selectedRow.ServiceData.Namespace
+					m.logsService = selectedRow.ServiceData.Name
+					m.logsPort = int(selectedRow.PortInfo.Port)
+					m.logsPod, m.logsErr, m.logsEnded, m.logsForwarded = "", nil, false, false
+					m.logLines, m.logScroll = nil, 0
+					return m, m.startForwardWithLogs
+				}
+
+			case key.Matches(msg, m.keys.ForwardAll):
+				if m.table.GetSelected() != nil {
+					m.statusMessage = ""
+					return m, m.toggleAllPortForwards
+				}
+
+			case key.Matches(msg, m.keys.Copy):
+				return m, m.copyForwardAddress
+
+			case key.Matches(msg, m.keys.CopyEnv):
+				return m, m.copyEnvExports
+
+			case key.Matches(msg, m.keys.CopyPod):
+				return m, m.copyForwardPod
+
+			case key.Matches(msg, m.keys.PortUp):
+				return m, m.nudgeLocalPort(1)
+
+			case key.Matches(msg, m.keys.PortDown):
+				return m, m.nudgeLocalPort(-1)
+
+			case key.Matches(msg, m.keys.Open):
+				return m, m.openForwardInBrowser
+
+			case key.Matches(msg, m.keys.Kubectl):
+				return m, m.showKubectlCommand
+
+			case key.Matches(msg, m.keys.Restart):
+				return m, m.restartSelectedForward()
+
+			case key.Matches(msg, m.keys.NextActive), key.Matches(msg, m.keys.PrevActive):
+				step := 1
+				if key.Matches(msg, m.keys.PrevActive) {
+					step = -1
+				}
+				if !m.table.SelectNextActive(step) {
+					m.statusMessage = "No active forwards"
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Search):
+				m.searching = true
+				m.searchInput = m.activeFilters["name"]
+				return m, nil
+
+			case key.Matches(msg, m.keys.Palette):
+				m.openPalette()
+				return m, nil
+
+			case key.Matches(msg, m.keys.Layout):
+				m.table.SetCompact(!m.table.Compact())
+				return m, nil
+
+			case key.Matches(msg, m.keys.Collapse):
+				m.table.SetCollapsed(!m.table.Collapsed())
+				return m, nil
+
+			case key.Matches(msg, m.keys.Group):
+				if m.options.GroupByLabel == "" {
+					m.statusMessage = "Start kpf with --group-by-label to group services by a label"
+					return m, clearStatusAfter(m.statusMessage)
+				}
+				if m.table.GroupLabel() == "" {
+					m.table.SetGroupLabel(m.options.GroupByLabel)
+				} else {
+					m.table.SetGroupLabel("")
+				}
+				m.table.SortBy(m.sortField, m.sortAscending)
+				return m, nil
+
 			case key.Matches(msg, m.keys.Detail):
 				selectedService := m.table.GetSelected()
 				if selectedService != nil {
 					m.detailViewService = *selectedService // Store a copy of the service we're viewing
 					m.viewMode = detailView
+					m.detailOffset = 0
 					m.deploymentInfo = nil // Clear previous deployment info
-					return m, m.loadDeploymentInfo
+					m.podInfos, m.podsErr, m.podsLoaded = nil, nil, false
+					m.podMetrics, m.podMetricsErr = nil, nil
+					return m, tea.Batch(m.loadDeploymentInfo, m.loadPodsInfo, m.loadPodMetrics)
 				}
 
 			case key.Matches(msg, m.keys.Refresh):
 				m.statusMessage = ""
-				m.lastRefresh = time.Now()
+				if m.lastRefresh.IsZero() && m.loadErr != nil {
+					// Retrying the first load, show the loading state again
+					m.loadErr = nil
+					m.loadingSince = time.Now()
+					return m, tea.Batch(m.loadServices, loadingTick())
+				}
+				return m, m.loadServices
+
+			case key.Matches(msg, m.keys.PauseRefresh):
+				return m, m.togglePauseRefresh()
+
+			case key.Matches(msg, m.keys.ForceRefresh):
+				m.client.InvalidateCache()
+				m.statusMessage = ""
 				return m, m.loadServices
 
 			case key.Matches(msg, m.keys.SortNamespace):
-				if m.sortField == "namespace" {
-					m.sortAscending = !m.sortAscending
-				} else {
-					m.sortField = "namespace"
-					m.sortAscending = true
-				}
-				m.table.SortBy(m.sortField, m.sortAscending)
-				return m, nil
+				return m, m.sortTable("namespace")
 
 			case key.Matches(msg, m.keys.SortName):
-				if m.sortField == "name" {
-					m.sortAscending = !m.sortAscending
-				} else {
-					m.sortField = "name"
-					m.sortAscending = true
-				}
-				m.table.SortBy(m.sortField, m.sortAscending)
-				return m, nil
+				return m, m.sortTable("name")
 
 			case key.Matches(msg, m.keys.SortStatus):
-				if m.sortField == "status" {
-					m.sortAscending = !m.sortAscending
-				} else {
-					m.sortField = "status"
-					m.sortAscending = true
-				}
-				m.table.SortBy(m.sortField, m.sortAscending)
-				return m, nil
+				return m, m.sortTable("status")
 
 			case key.Matches(msg, m.keys.SortPorts):
-				if m.sortField == "ports" {
-					m.sortAscending = !m.sortAscending
-				} else {
-					m.sortField = "ports"
-					m.sortAscending = true
-				}
-				m.table.SortBy(m.sortField, m.sortAscending)
-				return m, nil
+				return m, m.sortTable("ports")
 
 			case key.Matches(msg, m.keys.SortLocalPort):
-				if m.sortField == "localport" {
-					m.sortAscending = !m.sortAscending
-				} else {
-					m.sortField = "localport"
-					m.sortAscending = true
-				}
-				m.table.SortBy(m.sortField, m.sortAscending)
-				return m, nil
+				return m, m.sortTable("localport")
+
+			case key.Matches(msg, m.keys.SortAge):
+				return m, m.sortTable("age")
+
+			case key.Matches(msg, m.keys.SortStarted):
+				return m, m.sortTable("started")
+
+			case key.Matches(msg, m.keys.SortFailed):
+				return m, m.sortTable("failed")
 
 			case key.Matches(msg, m.keys.Help):
 				m.viewMode = helpView
 				return m, nil
 
+			case key.Matches(msg, m.keys.Forwards):
+				m.viewMode = forwardsView
+				m.forwardsIndex = 0
+				return m, nil
+
+			case key.Matches(msg, m.keys.StopAll):
+				if len(m.forwardManager.GetAllForwards()) == 0 {
+					m.statusMessage = "No forwards to stop"
+					return m, nil
+				}
+				m.viewMode = confirmStopAllView
+				return m, nil
+
+			case key.Matches(msg, m.keys.Export):
+				return m, m.exportTable()
+
+			case key.Matches(msg, m.keys.Ingresses):
+				m.viewMode = ingressView
+				m.ingresses, m.ingressesErr, m.ingressesLoaded = nil, nil, false
+				m.ingressIndex = 0
+				return m, m.loadIngresses
+
+			case key.Matches(msg, m.keys.Context):
+				m.viewMode = contextView
+				m.contexts, m.contextsErr, m.contextsLoaded = nil, nil, false
+				m.contextIndex = 0
+				return m, m.loadContexts
+
 			case key.Matches(msg, m.keys.Filter):
 				m.viewMode = filterView
 				m.filterInput = ""
@@ -422,6 +1049,31 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.viewMode = listView
 				return m, nil
 
+			case key.Matches(msg, m.keys.Up):
+				m.scrollDetail(-1)
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				m.scrollDetail(1)
+				return m, nil
+
+			case key.Matches(msg, m.keys.PageUp):
+				m.scrollDetail(-m.detailPageSize)
+				return m, nil
+
+			case key.Matches(msg, m.keys.PageDown):
+				m.scrollDetail(m.detailPageSize)
+				return m, nil
+
+			case key.Matches(msg, m.keys.Copy):
+				return m, m.copyForwardAddress
+
+			case key.Matches(msg, m.keys.CopyPod):
+				return m, m.copyForwardPod
+
+			case key.Matches(msg, m.keys.Open):
+				return m, m.openForwardInBrowser
+
 			case key.Matches(msg, m.keys.Forward):
 				selectedRow := m.table.GetSelectedRow()
 				if selectedRow != nil && selectedRow.PortInfo != nil {
@@ -482,6 +1134,234 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			default:
 				return m, nil
 			}
+		} else if m.viewMode == confirmStopAllView {
+			switch {
+			case msg.String() == "y" || msg.String() == "Y":
+				count := len(m.forwardManager.GetAllForwards())
+				m.stopAllForwards()
+				m.statusMessage = fmt.Sprintf("Stopped %d forwards", count)
+				m.viewMode = listView
+				return m, nil
+
+			case msg.String() == "n" || msg.String() == "N" || msg.String() == "q" || msg.Type == tea.KeyEsc:
+				m.viewMode = listView
+				return m, nil
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == confirmQuitView {
+			switch {
+			case msg.String() == "y" || msg.String() == "Y":
+				m.shutdown()
+				return m, tea.Quit
+
+			case msg.String() == "d" || msg.String() == "D":
+				return m, m.quitDetached()
+
+			case msg.String() == "n" || msg.String() == "N" || msg.String() == "q" || msg.Type == tea.KeyEsc:
+				m.viewMode = listView
+				return m, nil
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == podPickerView && m.pickerPod != "" {
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q":
+				// Back to the pods
+				m.pickerPod, m.pickerContainers = "", nil
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				if m.pickerContainerIndex > 0 {
+					m.pickerContainerIndex--
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				if m.pickerContainerIndex < len(m.pickerContainers)-1 {
+					m.pickerContainerIndex++
+				}
+				return m, nil
+
+			case msg.Type == tea.KeyEnter:
+				return m, m.forwardToPickedPod(m.pickerPod, m.pickerContainers[m.pickerContainerIndex].name)
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == podPickerView {
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q":
+				m.viewMode = listView
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				if m.pickerIndex > 0 {
+					m.pickerIndex--
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				if m.pickerIndex < len(m.pickerPods)-1 {
+					m.pickerIndex++
+				}
+				return m, nil
+
+			case msg.Type == tea.KeyEnter:
+				if m.pickerIndex < 0 || m.pickerIndex >= len(m.pickerPods) {
+					return m, nil
+				}
+				pod := m.pickerPods[m.pickerIndex]
+				if row := m.table.GetSelectedRow(); row != nil && row.PortInfo != nil && row.PortInfo.TargetPortName != "" {
+					if choices := containerChoices(pod, row.PortInfo.TargetPortName); len(choices) > 1 {
+						m.pickerPod, m.pickerContainers, m.pickerContainerIndex = pod.Name, choices, 0
+						return m, nil
+					}
+				}
+				return m, m.forwardToPickedPod(pod.Name, "")
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == forwardsView {
+			forwards := m.sortedForwards()
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q" || key.Matches(msg, m.keys.Forwards):
+				m.viewMode = listView
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				if m.forwardsIndex > 0 {
+					m.forwardsIndex--
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				if m.forwardsIndex < len(forwards)-1 {
+					m.forwardsIndex++
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.StopForward):
+				if m.forwardsIndex < 0 || m.forwardsIndex >= len(forwards) {
+					return m, nil
+				}
+				fw := forwards[m.forwardsIndex]
+				m.forwardManager.StopForward(fw.Namespace, fw.Service, fw.RemotePort)
+				m.statusMessage = fmt.Sprintf("Stopped forward %s/%s:%d", fw.Namespace, fw.Service, fw.RemotePort)
+				m.syncForwardStates()
+				if m.forwardsIndex >= len(forwards)-1 && m.forwardsIndex > 0 {
+					m.forwardsIndex--
+				}
+				return m, nil
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == logsView {
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q":
+				m.closeLogs()
+				m.viewMode = listView
+				return m, nil
+
+			case msg.String() == "x":
+				// Stopping the forward closes its logs too
+				m.closeLogs()
+				m.forwardManager.StopForward(m.logsNamespace, m.logsService, m.logsPort)
+				m.syncForwardStates()
+				m.viewMode = listView
+				m.statusMessage = fmt.Sprintf("Stopped forward %s/%s:%d", m.logsNamespace, m.logsService, m.logsPort)
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				m.scrollLogs(1)
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				m.scrollLogs(-1)
+				return m, nil
+
+			case key.Matches(msg, m.keys.PageUp):
+				m.scrollLogs(m.height / 2)
+				return m, nil
+
+			case key.Matches(msg, m.keys.PageDown):
+				m.scrollLogs(-m.height / 2)
+				return m, nil
+
+			case key.Matches(msg, m.keys.End):
+				m.logScroll = 0
+				return m, nil
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == ingressView {
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q" || key.Matches(msg, m.keys.Ingresses):
+				m.viewMode = listView
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				if m.ingressIndex > 0 {
+					m.ingressIndex--
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				if m.ingressIndex < len(m.ingresses)-1 {
+					m.ingressIndex++
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Refresh):
+				m.ingressesLoaded = false
+				return m, m.loadIngresses
+
+			case msg.Type == tea.KeyEnter || key.Matches(msg, m.keys.Forward):
+				if m.ingressIndex < 0 || m.ingressIndex >= len(m.ingresses) {
+					return m, nil
+				}
+				ing := m.ingresses[m.ingressIndex]
+				m.statusMessage = fmt.Sprintf("Forwarding %s/%s...", ing.Namespace, ing.ServiceName)
+				return m, m.startIngressForward(ing)
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == contextView {
+			switch {
+			case msg.Type == tea.KeyEsc || msg.String() == "q":
+				m.viewMode = listView
+				return m, nil
+
+			case key.Matches(msg, m.keys.Up):
+				if m.contextIndex > 0 {
+					m.contextIndex--
+				}
+				return m, nil
+
+			case key.Matches(msg, m.keys.Down):
+				if m.contextIndex < len(m.contexts)-1 {
+					m.contextIndex++
+				}
+				return m, nil
+
+			case msg.Type == tea.KeyEnter:
+				if m.contextIndex < 0 || m.contextIndex >= len(m.contexts) {
+					return m, nil
+				}
+				m.viewMode = listView
+				return m, m.switchContext(m.contexts[m.contextIndex])
+
+			default:
+				return m, nil
+			}
+		} else if m.viewMode == paletteView {
+			return m, m.updatePalette(msg)
 		} else if m.viewMode == filterView {
 			switch {
 			case msg.Type == tea.KeyEnter:
@@ -529,7 +1409,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case key.Matches(msg, key.NewBinding(key.WithKeys("right"), key.WithHelp("→", "next filter type"))):
 				// Right arrow cycles to next filter type
-				filterTypes := []string{"", "status", "type", "name", "protocol"}
+				filterTypes := []string{"", "status", "type", "name", "namespace", "protocol", "forwardable"}
 				currentIdx := 0
 				for i, ft := range filterTypes {
 					if ft == m.filterType {
@@ -545,7 +1425,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case key.Matches(msg, key.NewBinding(key.WithKeys("left"), key.WithHelp("←", "prev filter type"))):
 				// Left arrow cycles to previous filter type
-				filterTypes := []string{"", "status", "type", "name", "protocol"}
+				filterTypes := []string{"", "status", "type", "name", "namespace", "protocol", "forwardable"}
 				currentIdx := 0
 				for i, ft := range filterTypes {
 					if ft == m.filterType {
@@ -591,20 +1471,157 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case servicesLoadedMsg:
+		if msg.err != nil {
+			m.loadErr = msg.err
+			err := msg.err
+			return m, func() tea.Msg { return errorMsg{err: err} }
+		}
+		m.loadErr = nil
+		if msg.watched && m.refreshPaused {
+			m.pausedServices = msg.services
+			return m, m.waitForServiceUpdate(m.serviceWatch)
+		}
+		m.lastRefresh = time.Now()
 		m.table.SetServices(msg.services)
 		m.table.SortBy(m.sortField, m.sortAscending)
-		
-		// Initialize active forwards counter
-		activeCount := int64(0)
-		for _, row := range m.table.rows {
-			if row.ForwardingState == k8s.ForwardingStateActive {
-				activeCount++
+
+		// Stop forwards of services that were deleted from the cluster
+		if reaped := m.reapDeletedForwards(msg.services); len(reaped) > 0 {
+			m.statusMessage = fmt.Sprintf("Stopped forwards of deleted services: %s", strings.Join(reaped, ", "))
+		}
+		m.refreshActiveCount()
+
+		if msg.watched && m.serviceWatch != nil {
+			return m, m.waitForServiceUpdate(m.serviceWatch)
+		}
+		return m, nil
+
+	case serviceWatchStartedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Live updates unavailable: %v", msg.err)
+			return m, nil
+		}
+		m.serviceWatch = msg.updates
+		return m, m.waitForServiceUpdate(m.serviceWatch)
+
+	case autoRefreshTickMsg:
+		cmds = append(cmds, autoRefreshTick(m.options.RefreshInterval))
+		if !m.refreshPaused {
+			cmds = append(cmds, m.loadServices)
+		}
+		return m, tea.Batch(cmds...)
+
+	case forwardSyncTickMsg:
+		// Pick up state changes made by the manager in the background (e.g. reconnects)
+		m.syncForwardStates()
+		m.clampForwardsIndex()
+		if m.viewMode == logsView {
+			if m.logsForward() != nil {
+				m.logsForwarded = true
+			} else if m.logsForwarded {
+				// The forward was stopped elsewhere, take its logs down with it
+				m.closeLogs()
+				m.viewMode = listView
+				m.statusMessage = fmt.Sprintf("Forward %s/%s:%d stopped, closed its logs", m.logsNamespace, m.logsService, m.logsPort)
 			}
 		}
-		atomic.StoreInt64(&m.activeForwardsCount, activeCount)
-		
+		return m, tea.Batch(forwardSyncTick(), m.startPendingTick())
+
+	case forwardEventMsg:
+		m.syncForwardStates()
+		m.clampForwardsIndex()
+		key := fmt.Sprintf("%s/%s:%d", msg.event.Namespace, msg.event.Service, msg.event.RemotePort)
+		switch msg.event.Type {
+		case portforward.EventReconnecting:
+			m.statusMessage = fmt.Sprintf("Forward %s dropped, reconnecting (attempt %d)", key, msg.event.Attempt)
+		case portforward.EventReconnected:
+			m.statusMessage = fmt.Sprintf("Forward %s reconnected to %s", key, msg.event.Pod)
+			cmds = append(cmds, clearStatusAfter(m.statusMessage))
+		}
+		cmds = append(cmds, m.waitForForwardEvent(), m.startPendingTick())
+		return m, tea.Batch(cmds...)
+
+	case loadingTickMsg:
+		if !m.lastRefresh.IsZero() || m.loadErr != nil {
+			return m, nil
+		}
+		return m, loadingTick()
+
+	case pendingTickMsg:
+		if !m.table.HasPendingRows() {
+			m.pendingTicking = false
+			return m, nil
+		}
+		return m, pendingTick()
+
+	case statusMsg:
+		m.statusMessage = msg.text
+		return m, nil
+
+	case logsPodMsg:
+		if m.viewMode != logsView || msg.namespace != m.logsNamespace || msg.service != m.logsService || msg.port != m.logsPort {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.logsErr = msg.err
+			return m, nil
+		}
+		m.logsPod = msg.pod
+
+		cmds := []tea.Cmd{m.openLogStream(msg.namespace, msg.pod)}
+		if fw := m.logsForward(); fw == nil || fw.ForwardingState == k8s.ForwardingStateFailed {
+			cmds = append(cmds, func() tea.Msg {
+				return portForwardPendingMsg{namespace: msg.namespace, service: msg.service, port: msg.port}
+			}, m.forwardForLogs(msg.namespace, msg.service, msg.port, msg.pod))
+		}
+		return m, tea.Batch(cmds...)
+
+	case logsStartedMsg:
+		if m.viewMode != logsView || m.logStream != nil {
+			// The logs view was closed (or reopened) while the stream was opening
+			if msg.cancel != nil {
+				msg.cancel()
+			}
+			return m, nil
+		}
+		if msg.err != nil {
+			m.logsErr = msg.err
+			return m, nil
+		}
+		m.logStream = msg.lines
+		m.stopLogs = msg.cancel
+		return m, waitForLogLine(msg.lines)
+
+	case logLineMsg:
+		if msg.lines != m.logStream {
+			return m, nil
+		}
+		m.appendLogLine(msg.line)
+		return m, waitForLogLine(msg.lines)
+
+	case logsEndedMsg:
+		if msg.lines == m.logStream {
+			m.logsEnded = true
+		}
 		return m, nil
 
+	case bulkForwardMsg:
+		m.statusMessage = msg.summary
+		m.syncForwardStates()
+		return m, nil
+
+	case forwardsRestoredMsg:
+		if msg.err != nil {
+			m.statusMessage = "Some forwards could not be restored: " + strings.ReplaceAll(msg.err.Error(), "\n", "; ")
+		}
+		return m, m.loadServices
+
+	case startupForwardsMsg:
+		if msg.err != nil {
+			m.statusMessage = "Some --forward targets failed: " + strings.ReplaceAll(msg.err.Error(), "\n", "; ")
+		}
+		return m, m.loadServices
+
 	case servicesRefreshMsg:
 		// Refresh services to sync UI state with port forward manager state
 		return m, m.loadServices
@@ -630,7 +1647,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
-		return m, nil
+		return m, m.startPendingTick()
 
 	case portForwardFailedMsg:
 		// Update the current row's state directly to show failure immediately
@@ -658,30 +1675,45 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
+		m.statusMessage = msg.reason
 		return m, nil
 
 	case clusterInfoMsg:
 		m.context = msg.context
+		m.clusterHost = msg.host
 		return m, nil
 
 	case portForwardStartedMsg:
-		// Update the current row's state directly without rebuilding table
-		selectedRow := m.table.GetSelectedRow()
-		if selectedRow != nil && selectedRow.PortInfo != nil {
-			selectedRow.ForwardingState = k8s.ForwardingStateActive
-			selectedRow.ForwardingPort = msg.localPort
-			selectedRow.PortInfo.ForwardingState = k8s.ForwardingStateActive
-			selectedRow.PortInfo.ForwardingPort = msg.localPort
+		// Update the forward's rows directly without rebuilding the table. The
+		// cursor may have moved on while the forward was pending.
+		m.table.ForEachRow(func(row *ServiceTableRow) {
+			if row.PortInfo == nil || row.ServiceData.Namespace != msg.namespace ||
+				row.ServiceData.Name != msg.service || int(row.PortInfo.Port) != msg.port {
+				return
+			}
+			row.ForwardingState = k8s.ForwardingStateActive
+			row.ForwardingPort = msg.localPort
+			row.PortInfo.ForwardingState = k8s.ForwardingStateActive
+			row.PortInfo.ForwardingPort = msg.localPort
 			// Also update the service data copy
-			for i := range selectedRow.ServiceData.Ports {
-				if selectedRow.ServiceData.Ports[i].Port == selectedRow.PortInfo.Port {
-					selectedRow.ServiceData.Ports[i].ForwardingState = k8s.ForwardingStateActive
-					selectedRow.ServiceData.Ports[i].ForwardingPort = msg.localPort
+			for i := range row.ServiceData.Ports {
+				if row.ServiceData.Ports[i].Port == row.PortInfo.Port {
+					row.ServiceData.Ports[i].ForwardingState = k8s.ForwardingStateActive
+					row.ServiceData.Ports[i].ForwardingPort = msg.localPort
 					break
 				}
 			}
-			// Increment active forwards counter
-			atomic.AddInt64(&m.activeForwardsCount, 1)
+		})
+		m.refreshActiveCount()
+		m.statusMessage = fmt.Sprintf("Forwarding %s/%s:%d → localhost:%d", msg.namespace, msg.service, msg.port, msg.localPort)
+		if msg.requestedPort > 0 && msg.requestedPort != msg.localPort {
+			m.statusMessage += fmt.Sprintf(" (port %d was in use)", msg.requestedPort)
+		}
+		return m, clearStatusAfter(m.statusMessage)
+
+	case clearStatusMsg:
+		if m.statusMessage == msg.text {
+			m.statusMessage = ""
 		}
 		return m, nil
 
@@ -705,8 +1737,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					break
 				}
 			}
-			// Decrement active forwards counter
-			atomic.AddInt64(&m.activeForwardsCount, -1)
+		}
+		m.refreshActiveCount()
+		if msg.status != "" {
+			m.statusMessage = msg.status
+			return m, clearStatusAfter(m.statusMessage)
 		}
 		return m, nil
 
@@ -723,6 +1758,67 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.deploymentInfo = msg.deployment
 		return m, nil
 
+	case ingressesLoadedMsg:
+		m.ingresses = msg.ingresses
+		m.ingressesErr = msg.err
+		m.ingressesLoaded = true
+		if m.ingressIndex >= len(m.ingresses) {
+			m.ingressIndex = 0
+		}
+		return m, nil
+
+	case contextsLoadedMsg:
+		m.contexts = msg.contexts
+		m.contextsErr = msg.err
+		m.contextsLoaded = true
+		m.contextIndex = 0
+		current := m.client.CurrentContext()
+		for i, name := range m.contexts {
+			if name == current {
+				m.contextIndex = i
+				break
+			}
+		}
+		return m, nil
+
+	case contextSwitchedMsg:
+		if msg.err != nil {
+			return m, func() tea.Msg { return errorMsg{err: msg.err} }
+		}
+		m.statusMessage = fmt.Sprintf("Switched to context %s", msg.context)
+		if msg.stopped > 0 {
+			m.statusMessage += fmt.Sprintf(" - stopped %d active forwards", msg.stopped)
+		}
+
+		cmds := []tea.Cmd{m.loadServices, m.loadClusterInfo}
+		if m.stopWatch != nil {
+			// Restart the watch against the new cluster
+			m.stopWatch()
+			m.stopWatch = nil
+			m.serviceWatch = nil
+			cmds = append(cmds, m.startServiceWatch)
+		}
+		return m, tea.Batch(cmds...)
+
+	case pickerPodsLoadedMsg:
+		m.pickerPods = msg.pods
+		m.pickerErr = msg.err
+		m.pickerNamespaceWide = msg.namespaceWide
+		m.pickerLoaded = true
+		m.pickerIndex = 0
+		return m, nil
+
+	case podsLoadedMsg:
+		m.podInfos = msg.pods
+		m.podsErr = msg.err
+		m.podsLoaded = true
+		return m, nil
+
+	case podMetricsLoadedMsg:
+		m.podMetrics = msg.metrics
+		m.podMetricsErr = msg.err
+		return m, nil
+
 
 	case errorMsg:
 		// Show error modal instead of just storing the error
@@ -742,8 +1838,39 @@ func (m *Model) View() string {
 	if !m.ready {
 		return "\n  Initializing..."
 	}
+	if m.width < minTerminalWidth || m.height < minTerminalHeight {
+		return m.renderTooSmall()
+	}
+
+	return m.renderViewMode(m.viewMode)
+}
+
+// The smallest terminal the views are laid out for; anything smaller shows
+// a notice instead of a garbled table
+const (
+	minTerminalWidth  = 40
+	minTerminalHeight = 10
+)
+
+// renderTooSmall asks for a larger terminal, fitting whatever size there is
+func (m *Model) renderTooSmall() string {
+	lines := []string{
+		"Terminal too small",
+		fmt.Sprintf("%dx%d, need %dx%d", m.width, m.height, minTerminalWidth, minTerminalHeight),
+		"ctrl+c quits",
+	}
+	if len(lines) > m.height {
+		lines = lines[:max(m.height, 0)]
+	}
+	for i, line := range lines {
+		lines[i] = truncateString(line, max(m.width, 0))
+	}
+	return strings.Join(lines, "\n")
+}
 
-	switch m.viewMode {
+// renderViewMode renders the given view at the current terminal size
+func (m *Model) renderViewMode(mode viewMode) string {
+	switch mode {
 	case detailView:
 		return m.renderDetailView()
 	case helpView:
@@ -752,8 +1879,24 @@ func (m *Model) View() string {
 		return m.renderPortInputView()
 	case errorModalView:
 		return m.renderErrorModal()
+	case confirmStopAllView:
+		return m.renderConfirmStopAllModal()
+	case confirmQuitView:
+		return m.renderConfirmQuitModal()
 	case filterView:
 		return m.renderFilterView()
+	case podPickerView:
+		return m.renderPodPickerView()
+	case contextView:
+		return m.renderContextView()
+	case forwardsView:
+		return m.renderForwardsView()
+	case ingressView:
+		return m.renderIngressView()
+	case logsView:
+		return m.renderLogsView()
+	case paletteView:
+		return m.renderPaletteView()
 	default:
 		return m.renderListView()
 	}
@@ -767,7 +1910,7 @@ func (m *Model) renderListView() string {
 	sectionHeaders := m.renderSectionHeaders()
 
 	// Table content (includes header and data rows with proper scrolling)
-	tableContent := m.table.Render()
+	tableContent := m.renderServiceTable()
 
 	// Build main content
 	content := header + sectionHeaders + tableContent
@@ -790,12 +1933,35 @@ func (m *Model) renderListView() string {
 		filterIndicator = " [FILTERED]"
 	}
 	
-	footerText := fmt.Sprintf("[%d/%d]%s%s ↑↓/jk:navigate f:toggle-forward enter:details /:filter N/M/S/P/L:sort r:refresh ?/h:help q:quit", 
+	footerText := fmt.Sprintf("[%d/%d]%s%s ↑↓/jk:navigate f:toggle-forward enter:details /:filter N/M/S/P/L/A/T/E:sort r:refresh ctrl+r:restart ?/h:help q:quit", 
 		selected, total, sortIndicator, filterIndicator)
 
 	return RenderWithFooter(content, footerText, m.width, m.height)
 }
 
+// renderServiceTable renders the table, or the loading state before the first
+// service list arrives, or why the list is empty
+func (m *Model) renderServiceTable() string {
+	switch {
+	case m.lastRefresh.IsZero() && m.loadErr != nil:
+		return errorStyle.Render("Failed to load services: "+m.loadErr.Error()) + "\n\nPress r to retry"
+	case m.lastRefresh.IsZero():
+		elapsed := time.Since(m.loadingSince).Truncate(time.Second)
+		return fmt.Sprintf("%s Loading services... %s", pendingSpinner(), elapsed)
+	case !m.table.HasServices():
+		scope := "all namespaces"
+		if m.namespace != "all" {
+			scope = "namespace " + m.namespace
+		}
+		if selector := m.client.LabelSelector(); selector != "" {
+			scope += " matching " + selector
+		}
+		return fmt.Sprintf("No services found in %s\n\n", scope) +
+			staleStyle.Render("If you expected some, check that your RBAC role allows listing services there (kubectl auth can-i list services)")
+	}
+	return m.table.Render()
+}
+
 func (m *Model) renderHelpView() string {
 	// Header
 	header := m.renderAdminHeader()
@@ -807,11 +1973,30 @@ Navigation:
   ↓/j              Move down  
   pgup/pgdn        Page up/down
   home/end         Go to top/bottom
-  enter            View service details
+  n/tab, p/S-tab   Jump to the next/previous active forward
+  enter            View service details (the full error on a failed forward)
+  d                View service details
   esc/q            Back/Quit
+  ↑↓/pgup/pgdn     Scroll the ports list (in details)
 
 Port Forwarding:
-  f/F              Toggle port forward for selected service
+  f                Toggle port forward for selected service (cancels it while pending)
+  esc              Cancel the selected forward while it is pending
+  F                Forward selected port to a pod (and container) of your choice
+  l                Forward selected port and stream its pod's logs
+  a                Toggle port forwards for all ports of selected service
+  y                Copy localhost:<port> of the selected forward to the clipboard
+  Y                Copy export lines (e.g. API_ADDR=localhost:<port>) for all active forwards
+  u                Copy the pod name of the selected forward, for kubectl exec/logs
+  o                Open the selected forward in the default browser
+  K                Show the equivalent kubectl port-forward command
+  ctrl+r           Restart the selected active forward on a freshly looked up pod
+  +/-              Retry the selected failed forward one local port up/down
+  s                Quick search: type to narrow by name, enter keeps it, esc clears it
+  :                Forward by typing namespace/service:port (tab completes)
+  w                Toggle the compact layout (namespace, name, port, status)
+  z                Collapse services with several ports to one row (enter expands one)
+  g                Toggle grouping by the --group-by-label label
 
 Filtering:
   /                Open filter menu
@@ -820,10 +2005,12 @@ Filtering:
   C                Clear all filters (in filter menu)
   
   Filter types:
-    status         Filter by active/inactive forwarding
+    status         Filter by forwarding state (active/pending/failed/inactive)
     type           Filter by service type (ClusterIP/NodePort/LoadBalancer)
-    name           Filter by service name (partial match)
-    protocol       Filter by protocol (TCP/UDP)
+    name           Filter by service name (partial match, /regex for a regular expression)
+    namespace      Filter by namespace
+    protocol       Filter by protocol (TCP/UDP/SCTP)
+    forwardable    true hides services a forward would fail for (no ports/endpoints, ExternalName)
 
 Sorting:
   N                Sort by namespace
@@ -831,10 +2018,21 @@ Sorting:
   S                Sort by status (forwarding active/inactive)
   P                Sort by ports
   L                Sort by local port
+  A                Sort by age (newest first)
+  T                Sort by forward start (latest forwards first)
+  E                Sort failed forwards first
 
 Other:
-  r                Refresh service list
+  e                Export the shown rows to a timestamped JSON (and CSV) file
+  i                View ingresses (enter/f forwards the backing service)
+  v                View all forwards (x/enter stops the highlighted one)
+  X                Stop all forwards (asks for confirmation)
+  c                Switch kubeconfig context (stops active forwards)
+  r                Refresh service list (reuses results from the last few seconds; ctrl+r restarts a forward instead)
+  R                Refresh service list from the API server
+  space            Pause/resume auto-refresh (--refresh-interval) and live updates (--watch)
   ?/h              Show/hide this help
+  d                Quit but keep the active forwards running in the background (in the quit prompt)
   Ctrl+C           Quit application
 
 Status Indicators:
@@ -851,7 +2049,13 @@ Service Type Legend:
   -                Unknown/Other service type
 `
 
-	content := header + sectionHeaderStyle.Render("Help") + "\n" + helpContent
+	// Cut help lines to the terminal width so they don't wrap and push the
+	// footer off screen on narrow terminals
+	lines := strings.Split(helpContent, "\n")
+	for i, line := range lines {
+		lines[i] = truncateString(line, m.width)
+	}
+	content := header + sectionHeaderStyle.Render("Help") + "\n" + strings.Join(lines, "\n")
 
 	footerText := "Press ?/h or ESC to close help"
 	return RenderWithFooter(content, footerText, m.width, m.height)
@@ -870,20 +2074,24 @@ func (m *Model) renderAdminHeader() string {
 	leftSide := fmt.Sprintf("KPF%s: Now(%s/%d)", commitInfo, humanTime, epochTime)
 
 	// Right side with cluster info and kubeconfig path
-	kubeconfigPath := m.kubeconfig
-	if kubeconfigPath != "" {
-		// Show just the filename, not the full path for space
-		if lastSlash := strings.LastIndex(kubeconfigPath, "/"); lastSlash != -1 {
-			kubeconfigPath = kubeconfigPath[lastSlash+1:]
-		}
+	// Show just the file names, not the full paths, for space. Merged
+	// kubeconfigs list every file.
+	var kubeconfigFiles []string
+	for _, path := range filepath.SplitList(m.kubeconfig) {
+		kubeconfigFiles = append(kubeconfigFiles, filepath.Base(path))
 	}
+	kubeconfigPath := strings.Join(kubeconfigFiles, ",")
 	
 	// If we have the original kubeconfig from CLI args, prefer showing that filename
-	rightSide := fmt.Sprintf("[ Cluster: %s | Config: %s ]", m.context, kubeconfigPath)
+	cluster := m.context
+	if m.clusterHost != "" {
+		cluster += " (" + m.clusterHost + ")"
+	}
+	rightSide := fmt.Sprintf("[ Cluster: %s | Config: %s ]", cluster, kubeconfigPath)
 
 	// Calculate spacing
-	leftLen := len(leftSide)
-	rightLen := len(rightSide)
+	leftLen := lipgloss.Width(leftSide)
+	rightLen := lipgloss.Width(rightSide)
 	availableSpace := m.width - 2
 
 	var headerText string
@@ -908,7 +2116,25 @@ func (m *Model) renderSectionHeaders() string {
 	if m.namespace != "" && m.namespace != "all" {
 		envText = fmt.Sprintf("Environment: %s", m.namespace)
 	}
-	content.WriteString(sectionHeaderStyle.Render(envText) + "\n")
+	content.WriteString(sectionHeaderStyle.Render(envText))
+	if !m.lastRefresh.IsZero() {
+		// A watch keeps the list current even when nothing changes for a while
+		age := time.Since(m.lastRefresh)
+		refreshed := fmt.Sprintf(" - refreshed %s ago", formatAge(age))
+		if age > staleAfter && (m.refreshPaused || (m.serviceWatch == nil && m.options.RefreshInterval <= 0)) {
+			content.WriteString(staleStyle.Render(refreshed + ", may be stale"))
+		} else {
+			content.WriteString(sectionHeaderStyle.Render(refreshed))
+		}
+	}
+	if indicator := m.autoRefreshIndicator(); indicator != "" {
+		if m.refreshPaused {
+			content.WriteString(staleStyle.Render(indicator))
+		} else {
+			content.WriteString(sectionHeaderStyle.Render(indicator))
+		}
+	}
+	content.WriteString("\n")
 
 	// Services count (matching admin style)
 	services := m.table.services
@@ -923,7 +2149,16 @@ func (m *Model) renderSectionHeaders() string {
 	} else {
 		countText = fmt.Sprintf("Services (%d) - Active Forwards (%d)", totalCount, activeCount)
 	}
-	content.WriteString(sectionHeaderStyle.Render(countText) + "\n")
+	if activeCount > 0 {
+		summary := m.forwardSummary
+		countText += fmt.Sprintf(" - Up %s (↓%s ↑%s)",
+			formatAge(time.Since(summary.OldestStart)), formatBytes(summary.BytesIn), formatBytes(summary.BytesOut))
+	}
+	content.WriteString(sectionHeaderStyle.Render(countText))
+	if failed := m.forwardSummary.Failed; failed > 0 {
+		content.WriteString(sectionHeaderStyle.Render(" - ") + failedStyle.Render(fmt.Sprintf("Failed Forwards (%d, E sorts them first)", failed)))
+	}
+	content.WriteString("\n")
 	
 	// Show active filters if any
 	if len(m.activeFilters) > 0 {
@@ -935,6 +2170,14 @@ func (m *Model) renderSectionHeaders() string {
 		content.WriteString(sectionHeaderStyle.Render(filterText) + "\n")
 	}
 
+	if m.searching {
+		content.WriteString("Search: " + m.searchInput + "_\n")
+	}
+
+	if m.statusMessage != "" {
+		content.WriteString(m.statusMessage + "\n")
+	}
+
 	// Blank line before table
 	content.WriteString("\n")
 
@@ -943,6 +2186,10 @@ func (m *Model) renderSectionHeaders() string {
 
 
 
+// staleAfter is how old the service list may get before the header warns
+// that it may be out of date
+const staleAfter = 60 * time.Second
+
 // formatAge formats a duration into k9s-style age string
 func formatAge(d time.Duration) string {
 	if d < time.Minute {
@@ -956,6 +2203,20 @@ func formatAge(d time.Duration) string {
 	}
 }
 
+// formatBytes formats a byte count using binary units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 func (m *Model) renderDetailView() string {
 	svc := &m.detailViewService
 	if svc.Name == "" {
@@ -966,31 +2227,91 @@ func (m *Model) renderDetailView() string {
 	header := m.renderAdminHeader()
 	sectionHeaders := m.renderSectionHeaders()
 
-	// Service details box
-	serviceDetails := m.renderServiceDetails(*svc)
-	serviceBox := detailBoxStyle.Width(m.width-4).Render(serviceDetails)
-	
 	// Deployment details box (if available)
 	deploymentBox := ""
 	if m.deploymentInfo != nil {
 		deploymentDetails := m.renderDeploymentDetails(*m.deploymentInfo)
 		deploymentBox = "\n\n" + detailBoxStyle.Width(m.width-4).Render(deploymentDetails)
 	}
+
+	// Pods box once the pod list has loaded
+	podsBox := ""
+	if m.podsLoaded {
+		podsBox = "\n\n" + detailBoxStyle.Width(m.width-4).Render(m.renderPodDetails())
+	}
+
+	// Fit as many ports as the remaining height allows; the service header
+	// stays pinned and the ports list scrolls
+	serviceHeader := m.renderServiceHeader(*svc)
+	usedLines := lipgloss.Height(header+sectionHeaders) + lipgloss.Height(serviceHeader) +
+		lipgloss.Height(deploymentBox) + lipgloss.Height(podsBox) +
+		detailBoxStyle.GetVerticalFrameSize() + 1 // +1 for the footer
+	m.detailPageSize = (m.height - usedLines) / linesPerDetailPort
+	if m.detailPageSize < 1 {
+		m.detailPageSize = 1
+	}
+	m.scrollDetail(0)
+
+	// Service details box
+	serviceDetails := serviceHeader + m.renderPortDetails(svc.Ports, m.detailOffset, m.detailPageSize)
+	serviceBox := detailBoxStyle.Width(m.width-4).Render(serviceDetails)
 	
-	content := header + sectionHeaders + serviceBox + deploymentBox
+	content := header + sectionHeaders + serviceBox + deploymentBox + podsBox
 
 	// Footer for detail view
-	footerText := "f:toggle-forward q/esc:back Ctrl+C:exit"
+	footerText := "↑↓/jk:scroll-ports f:toggle-forward y:copy-address o:open q/esc:back Ctrl+C:exit"
 
 	return RenderWithFooter(content, footerText, m.width, m.height)
 }
 
-func (m *Model) renderServiceDetails(svc k8s.ServiceInfo) string {
+// resolveNamedTargetPort returns the container port a named target port
+// stands for: the one a forward resolved, or else the one of the first loaded
+// pod defining it. 0 means it is not known.
+func (m *Model) resolveNamedTargetPort(port k8s.PortInfo) int {
+	if port.ForwardTargetPort != 0 && port.ForwardingState != k8s.ForwardingStateInactive {
+		return port.ForwardTargetPort
+	}
+	for _, pod := range m.podInfos {
+		if containerPort, ok := pod.NamedPort(port.TargetPortName); ok {
+			return int(containerPort)
+		}
+	}
+	return 0
+}
+
+// linesPerDetailPort is how many lines each port takes in the detail view
+const linesPerDetailPort = 2
+
+// scrollDetail moves the detail view's ports list by delta, keeping it in range
+func (m *Model) scrollDetail(delta int) {
+	m.detailOffset += delta
+	maxOffset := len(m.detailViewService.Ports) - m.detailPageSize
+	if m.detailOffset > maxOffset {
+		m.detailOffset = maxOffset
+	}
+	if m.detailOffset < 0 {
+		m.detailOffset = 0
+	}
+}
+
+// renderServiceHeader renders the service fields shown above the ports list
+func (m *Model) renderServiceHeader(svc k8s.ServiceInfo) string {
 	var s strings.Builder
 
 	s.WriteString(detailLabelStyle.Render("Service") + ": " + svc.Name + "\n")
 	s.WriteString(detailLabelStyle.Render("Namespace") + ": " + svc.Namespace + "\n")
 	s.WriteString(detailLabelStyle.Render("Type") + ": " + svc.Type + "\n")
+	if addresses := externalAddresses(svc); len(addresses) > 0 {
+		s.WriteString(detailLabelStyle.Render("External Address") + ": " + strings.Join(addresses, ", ") + "\n")
+	} else if svc.Type == "LoadBalancer" {
+		s.WriteString(detailLabelStyle.Render("External Address") + ": <pending>\n")
+	}
+	if svc.Type == "ExternalName" && svc.Service != nil {
+		s.WriteString(detailLabelStyle.Render("External Name") + ": " + svc.Service.Spec.ExternalName + "\n")
+		s.WriteString(detailLabelStyle.Render("CNAME") + ": " +
+			fmt.Sprintf("%s.%s.svc → %s (not port-forwardable, connect to it directly)",
+				svc.Name, svc.Namespace, svc.Service.Spec.ExternalName) + "\n")
+	}
 	
 	// Add age if available
 	if svc.Service != nil && !svc.Service.CreationTimestamp.IsZero() {
@@ -1000,13 +2321,41 @@ func (m *Model) renderServiceDetails(svc k8s.ServiceInfo) string {
 	
 	s.WriteString("\n")
 
-	s.WriteString(detailLabelStyle.Render("Ports") + ":\n")
-	for _, port := range svc.Ports {
+	return s.String()
+}
+
+// renderPortDetails renders up to limit ports starting at offset
+func (m *Model) renderPortDetails(ports []k8s.PortInfo, offset, limit int) string {
+	var s strings.Builder
+
+	end := offset + limit
+	if end > len(ports) {
+		end = len(ports)
+	}
+	if offset > 0 || end < len(ports) {
+		s.WriteString(detailLabelStyle.Render("Ports") + fmt.Sprintf(" (%d-%d of %d):\n", offset+1, end, len(ports)))
+	} else {
+		s.WriteString(detailLabelStyle.Render("Ports") + ":\n")
+	}
+
+	for _, port := range ports[offset:end] {
 		portInfo := fmt.Sprintf("  • %s: %d", port.Name, port.Port)
-		if port.TargetPort != 0 && port.TargetPort != port.Port {
+		if port.TargetPortName != "" {
+			if containerPort := m.resolveNamedTargetPort(port); containerPort != 0 {
+				portInfo += fmt.Sprintf(" → %d(%s)", containerPort, port.TargetPortName)
+			} else {
+				portInfo += fmt.Sprintf(" → %s", port.TargetPortName)
+			}
+		} else if port.TargetPort != 0 && port.TargetPort != port.Port {
 			portInfo += fmt.Sprintf(" → %d", port.TargetPort)
 		}
 		portInfo += fmt.Sprintf(" (%s)", port.Protocol)
+		if port.NodePort != 0 {
+			portInfo += fmt.Sprintf(", node port %d", port.NodePort)
+		}
+		if !port.Forwardable() {
+			portInfo += " - unsupported, port forwarding is TCP only"
+		}
 		s.WriteString(portInfo + "\n")
 		
 		// Add per-port forwarding status on separate line with indentation
@@ -1014,7 +2363,14 @@ func (m *Model) renderServiceDetails(svc k8s.ServiceInfo) string {
 		switch port.ForwardingState {
 		case k8s.ForwardingStateActive:
 			duration := time.Since(port.ForwardStartTime)
-			statusInfo += fmt.Sprintf("Status: FORWARDING → localhost:%d for %s", port.ForwardingPort, formatAge(duration))
+			statusInfo += fmt.Sprintf("Status: FORWARDING → localhost:%d for %s (↓%s ↑%s)",
+				port.ForwardingPort, formatAge(duration), formatBytes(port.BytesIn), formatBytes(port.BytesOut))
+			if port.ForwardPod != "" {
+				statusInfo += " → pod/" + port.ForwardPod
+				if port.ForwardContainer != "" {
+					statusInfo += fmt.Sprintf(" (container %s)", port.ForwardContainer)
+				}
+			}
 		case k8s.ForwardingStatePending:
 			duration := time.Since(port.ForwardStartTime)
 			statusInfo += fmt.Sprintf("Status: ESTABLISHING → localhost:%d for %s", port.ForwardingPort, formatAge(duration))
@@ -1035,9 +2391,16 @@ func (m *Model) renderServiceDetails(svc k8s.ServiceInfo) string {
 func (m *Model) renderDeploymentDetails(dep k8s.DeploymentInfo) string {
 	var s strings.Builder
 
-	s.WriteString(detailLabelStyle.Render("Deployment") + ": " + dep.Name + "\n")
-	s.WriteString(detailLabelStyle.Render("Replicas") + ": " + fmt.Sprintf("%d/%d ready", dep.ReadyReplicas, dep.Replicas) + "\n")
-	s.WriteString(detailLabelStyle.Render("Updated") + ": " + fmt.Sprintf("%d replicas", dep.UpdatedReplicas) + "\n")
+	if dep.BarePods {
+		// No controller owns the pods, describe them directly
+		s.WriteString(detailLabelStyle.Render("Workload") + ": " + dep.Name + "\n")
+		s.WriteString(detailLabelStyle.Render("Ready") + ": " + fmt.Sprintf("%d/%d pods", dep.ReadyReplicas, dep.Replicas) + "\n")
+		s.WriteString(detailLabelStyle.Render("Restarts") + ": " + fmt.Sprintf("%d", dep.Restarts) + "\n")
+	} else {
+		s.WriteString(detailLabelStyle.Render("Deployment") + ": " + dep.Name + "\n")
+		s.WriteString(detailLabelStyle.Render("Replicas") + ": " + fmt.Sprintf("%d/%d ready", dep.ReadyReplicas, dep.Replicas) + "\n")
+		s.WriteString(detailLabelStyle.Render("Updated") + ": " + fmt.Sprintf("%d replicas", dep.UpdatedReplicas) + "\n")
+	}
 	s.WriteString(detailLabelStyle.Render("Image") + ": " + dep.Image + "\n")
 	
 	// Format age
@@ -1047,11 +2410,59 @@ func (m *Model) renderDeploymentDetails(dep k8s.DeploymentInfo) string {
 	return s.String()
 }
 
+func (m *Model) renderPodDetails() string {
+	var s strings.Builder
+
+	s.WriteString(detailLabelStyle.Render("Pods") + ":\n")
+	if m.podsErr != nil {
+		s.WriteString("  " + errorStyle.Render(m.podsErr.Error()))
+		return s.String()
+	}
+	if len(m.podInfos) == 0 {
+		s.WriteString("  No pods found for this service")
+		return s.String()
+	}
+
+	for i, pod := range m.podInfos {
+		ready := activeStyle.Render("ready")
+		if !pod.Ready {
+			ready = failedStyle.Render("not ready")
+		}
+		s.WriteString(fmt.Sprintf("  • %s: %s, %s, %d restarts, age %s",
+			pod.Name, pod.Phase, ready, pod.Restarts, formatAge(time.Since(pod.CreatedAt))))
+		if usage, ok := m.podMetrics[pod.Name]; ok {
+			s.WriteString(fmt.Sprintf(", cpu %dm, memory %s", usage.CPUMilli, formatBytes(usage.MemoryBytes)))
+		}
+		if i < len(m.podInfos)-1 {
+			s.WriteString("\n")
+		}
+	}
+
+	// Not every cluster runs metrics-server, the pod list stands on its own
+	if errors.Is(m.podMetricsErr, k8s.ErrMetricsUnavailable) {
+		s.WriteString("\n  " + inactiveStyle.Render("CPU/memory: metrics-server unavailable"))
+	} else if m.podMetricsErr != nil {
+		s.WriteString("\n  " + errorStyle.Render(m.podMetricsErr.Error()))
+	}
+
+	return s.String()
+}
+
+// capitalize upper-cases the first letter of an error message for display
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// padRight pads or cuts s to exactly n terminal cells
 func padRight(s string, n int) string {
-	if len(s) >= n {
-		return s[:n]
+	if n < 0 {
+		n = 0
 	}
-	return s + strings.Repeat(" ", n-len(s))
+	s = runewidth.Truncate(s, n, "")
+	return s + strings.Repeat(" ", n-runewidth.StringWidth(s))
 }
 
 func (m *Model) renderPortInputView() string {
@@ -1079,11 +2490,10 @@ func (m *Model) renderPortInputView() string {
 	
 	// Validation message
 	if len(m.portInput) > 0 {
-		var port int
-		if n, err := fmt.Sscanf(m.portInput, "%d", &port); n != 1 || err != nil {
-			content += errorStyle.Render("Invalid port number") + "\n"
-		} else if port > 65535 || port < 1024 {
-			content += errorStyle.Render("Port must be between 1024-65535") + "\n"
+		if port, err := m.parseLocalPort(m.portInput); err != nil {
+			content += errorStyle.Render(capitalize(err.Error())) + "\n"
+		} else if port < minUnprivilegedPort {
+			content += staleStyle.Render("Ports below 1024 need root or CAP_NET_BIND_SERVICE") + "\n"
 		}
 	}
 
@@ -1094,13 +2504,10 @@ func (m *Model) renderPortInputView() string {
 
 func (m *Model) renderErrorModal() string {
 	// Create the error modal content first
-	modalWidth := 60
-	if modalWidth > m.width-10 {
-		modalWidth = m.width - 10
-	}
+	modalWidth := m.modalWidth()
 	
 	// Wrap the error message
-	wrappedMessage := wordWrap(m.errorMessage, modalWidth-8)
+	wrappedMessage := wordWrap(m.errorMessage, modalWidth-6)
 	
 	// Build the modal content
 	var modalLines []string
@@ -1109,31 +2516,74 @@ func (m *Model) renderErrorModal() string {
 	modalLines = append(modalLines, "│"+strings.Repeat(" ", modalWidth-2)+"│")
 	
 	for _, line := range wrappedMessage {
-		padding := modalWidth - len(line) - 4
-		if padding < 0 {
-			padding = 0
-			line = line[:modalWidth-4]
-		}
-		modalLines = append(modalLines, "│  "+line+strings.Repeat(" ", padding)+"  │")
+		modalLines = append(modalLines, "│  "+padRight(line, modalWidth-6)+"  │")
 	}
 	
 	modalLines = append(modalLines, "│"+strings.Repeat(" ", modalWidth-2)+"│")
-	modalLines = append(modalLines, "│  Press Enter/Esc/Space to dismiss"+strings.Repeat(" ", modalWidth-37)+"│")
+	modalLines = append(modalLines, "│"+padRight("  Press Enter/Esc/Space to dismiss", modalWidth-2)+"│")
 	modalLines = append(modalLines, "╰"+strings.Repeat("─", modalWidth-2)+"╯")
 	
-	// Get the background view
-	var backgroundView string
-	switch m.previousView {
-	case detailView:
-		backgroundView = m.renderDetailView()
-	case helpView:
-		backgroundView = m.renderHelpView()
-	case portInputView:
-		backgroundView = m.renderPortInputView()
-	default:
-		backgroundView = m.renderListView()
+	// Render the view the error came from underneath, at the current size
+	background := m.previousView
+	if background == errorModalView {
+		background = listView
 	}
-	
+	return m.overlayModal(m.renderViewMode(background), modalLines, modalWidth, lipgloss.Color("#880000"))
+}
+
+// renderConfirmStopAllModal asks for confirmation before stopping every forward
+func (m *Model) renderConfirmStopAllModal() string {
+	modalWidth := m.modalWidth()
+
+	count := len(m.forwardManager.GetAllForwards())
+	question := fmt.Sprintf("Stop %d active forwards? y/n", count)
+	if count == 1 {
+		question = "Stop 1 active forward? y/n"
+	}
+
+	var modalLines []string
+	modalLines = append(modalLines, "╭"+strings.Repeat("─", modalWidth-2)+"╮")
+	modalLines = append(modalLines, "│"+padRight("  "+question, modalWidth-2)+"│")
+	modalLines = append(modalLines, "╰"+strings.Repeat("─", modalWidth-2)+"╯")
+
+	return m.overlayModal(m.renderListView(), modalLines, modalWidth, lipgloss.Color("#005577"))
+}
+
+// renderConfirmQuitModal asks before quitting stops the active forwards
+func (m *Model) renderConfirmQuitModal() string {
+	modalWidth := m.modalWidth()
+
+	count := atomic.LoadInt64(&m.activeForwardsCount)
+	question := fmt.Sprintf("You have %d active forwards. Quit and stop them? y:stop d:keep them running in the background n:cancel", count)
+	if count == 1 {
+		question = "You have 1 active forward. Quit and stop it? y:stop d:keep it running in the background n:cancel"
+	}
+
+	var modalLines []string
+	modalLines = append(modalLines, "╭"+strings.Repeat("─", modalWidth-2)+"╮")
+	for _, line := range wordWrap(question, modalWidth-6) {
+		modalLines = append(modalLines, "│"+padRight("  "+line, modalWidth-2)+"│")
+	}
+	modalLines = append(modalLines, "╰"+strings.Repeat("─", modalWidth-2)+"╯")
+
+	return m.overlayModal(m.renderListView(), modalLines, modalWidth, lipgloss.Color("#005577"))
+}
+
+// modalWidth is the width of modals, narrowed to fit small terminals
+func (m *Model) modalWidth() int {
+	width := 60
+	if width > m.width-10 {
+		width = m.width - 10
+	}
+	// The modal borders and error title need some room even if that overflows
+	if width < 20 {
+		width = 20
+	}
+	return width
+}
+
+// overlayModal draws modalLines centered on top of backgroundView
+func (m *Model) overlayModal(backgroundView string, modalLines []string, modalWidth int, background lipgloss.Color) string {
 	// Split background into lines
 	backgroundLines := strings.Split(backgroundView, "\n")
 	
@@ -1151,29 +2601,33 @@ func (m *Model) renderErrorModal() string {
 	for i, modalLine := range modalLines {
 		row := startRow + i
 		if row >= 0 && row < len(backgroundLines) {
-			// Create a new line with the modal overlaid
-			bgLine := backgroundLines[row]
+			// Create a new line with the modal overlaid. Styles of the covered
+			// rows are dropped so columns are counted in cells, not escape bytes.
+			bgLine := []rune(ansiPattern.ReplaceAllString(backgroundLines[row], ""))
 			// Ensure background line is long enough
 			for len(bgLine) < m.width {
-				bgLine += " "
+				bgLine = append(bgLine, ' ')
 			}
 			
 			// Replace the section of the background line with the modal line
-			if startCol >= 0 {
+			if startCol < 0 {
+				startCol = 0
+			}
+			{
 				before := ""
 				if startCol > 0 && startCol < len(bgLine) {
-					before = bgLine[:startCol]
+					before = string(bgLine[:startCol])
 				}
 				after := ""
-				endCol := startCol + len(modalLine)
+				endCol := startCol + lipgloss.Width(modalLine)
 				if endCol < len(bgLine) {
-					after = bgLine[endCol:]
+					after = string(bgLine[endCol:])
 				}
 				
-				// Style the modal line with error colors
+				// Style the modal line with the modal colors
 				styledModalLine := lipgloss.NewStyle().
 					Foreground(lipgloss.Color("#FFFFFF")).
-					Background(lipgloss.Color("#880000")).
+					Background(background).
 					Render(modalLine)
 				
 				backgroundLines[row] = before + styledModalLine + after
@@ -1197,7 +2651,7 @@ func wordWrap(text string, width int) []string {
 	for _, word := range words {
 		if currentLine.Len() == 0 {
 			currentLine.WriteString(word)
-		} else if currentLine.Len()+1+len(word) <= width {
+		} else if runewidth.StringWidth(currentLine.String())+1+runewidth.StringWidth(word) <= width {
 			currentLine.WriteString(" " + word)
 		} else {
 			lines = append(lines, currentLine.String())
@@ -1213,11 +2667,200 @@ func wordWrap(text string, width int) []string {
 	return lines
 }
 
+// sortTable sorts the table by field, flipping the direction if it is sorted
+// by field already, and remembers the choice for the next session
+func (m *Model) sortTable(field string) tea.Cmd {
+	if m.sortField == field {
+		m.sortAscending = !m.sortAscending
+	} else {
+		m.sortField = field
+		m.sortAscending = true
+	}
+	m.table.SortBy(m.sortField, m.sortAscending)
+
+	if m.preferencesPath == "" {
+		return nil
+	}
+	path, prefs := m.preferencesPath, preferences{SortField: m.sortField, SortAscending: m.sortAscending}
+	return func() tea.Msg {
+		// Best effort, a failed save only loses the preference
+		_ = savePreferences(path, prefs)
+		return nil
+	}
+}
+
+// syncForwardStates copies the current forward manager state onto the table rows
+func (m *Model) syncForwardStates() {
+	m.table.ForEachRow(func(row *ServiceTableRow) {
+		if row.PortInfo == nil {
+			return
+		}
+
+		fwInfo := m.forwardManager.GetForwardInfo(row.Namespace, row.Name, int(row.Port))
+		if fwInfo == nil {
+			// Leave optimistic pending rows alone until the manager knows about them
+			if row.ForwardingState != k8s.ForwardingStatePending {
+				row.ForwardingState = k8s.ForwardingStateInactive
+				row.ForwardingPort = 0
+				row.PortInfo.ForwardingState = k8s.ForwardingStateInactive
+				row.PortInfo.ForwardingPort = 0
+			}
+			return
+		}
+
+		row.ForwardingState = fwInfo.ForwardingState
+		row.ForwardingPort = fwInfo.LocalPort
+		row.PortInfo.ForwardingState = fwInfo.ForwardingState
+		row.PortInfo.ForwardingPort = fwInfo.LocalPort
+		row.PortInfo.ForwardStartTime = fwInfo.StartedAt
+		row.PortInfo.ForwardPod = fwInfo.PodName
+		row.PortInfo.ForwardTargetPort = fwInfo.TargetPort
+		row.PortInfo.ForwardContainer = fwInfo.Container
+		row.PortInfo.FailureReason = fwInfo.FailureReason
+		row.PortInfo.FailureTime = fwInfo.FailureTime
+		row.PortInfo.BytesIn = fwInfo.BytesIn
+		row.PortInfo.BytesOut = fwInfo.BytesOut
+	})
+
+	m.refreshActiveCount()
+}
+
+// refreshActiveCount takes the active forwards count and summary from the
+// manager, which also knows about forwards whose rows are gone. The header
+// renders the stored summary so frames don't walk the forwards.
+func (m *Model) refreshActiveCount() {
+	m.forwardSummary = portforward.SummaryOf(m.forwardManager.GetAllForwards())
+	atomic.StoreInt64(&m.activeForwardsCount, int64(m.forwardSummary.Active))
+}
+
+// reapDeletedForwards stops the forwards of services missing from a fresh
+// service list and returns their keys. Only namespaces the list covers are
+// checked, skipping those left out by the namespace filter, and nothing is reaped under a label selector since the list leaves
+// out every service it doesn't match.
+func (m *Model) reapDeletedForwards(services []k8s.ServiceInfo) []string {
+	reaper, ok := m.forwardManager.(portforward.Reaper)
+	if !ok || m.client.LabelSelector() != "" {
+		return nil
+	}
+
+	existing := make(map[string]bool, len(services))
+	for _, svc := range services {
+		existing[svc.Namespace+"/"+svc.Name] = true
+	}
+
+	namespace := m.namespace
+	if namespace == "all" {
+		namespace = ""
+	}
+	filter := m.client.NamespaceFilter()
+	return reaper.ReapForwards(namespace, func(ns, serviceName string) bool {
+		// The filter only applies to all namespaces mode
+		if namespace == "" && !filter.Allows(ns) {
+			return true
+		}
+		return existing[ns+"/"+serviceName]
+	})
+}
+
+// toggleSelectedForward starts, stops or retries the forward of the selected row
+func (m *Model) toggleSelectedForward() tea.Cmd {
+	selected := m.table.GetSelected()
+	selectedRow := m.table.GetSelectedRow()
+	if selected == nil || selectedRow == nil || selectedRow.PortInfo == nil {
+		return nil
+	}
+
+	// For inactive ports, send pending message immediately, then start port forward
+	if selectedRow.PortInfo.ForwardingState == k8s.ForwardingStateInactive {
+		return tea.Batch(
+			func() tea.Msg {
+				return portForwardPendingMsg{
+					namespace: selectedRow.ServiceData.Namespace,
+					service:   selectedRow.ServiceData.Name,
+					port:      int(selectedRow.PortInfo.Port),
+				}
+			},
+			m.startPortForward,
+		)
+	}
+	// For active or failed ports, just start port forward (will toggle or retry)
+	return m.startPortForward
+}
+
+// startsForward reports whether msg would start a forward in the current
+// view, which read-only mode refuses
+func (m *Model) startsForward(msg tea.KeyMsg) bool {
+	switch m.viewMode {
+	case listView:
+		return key.Matches(msg, m.keys.Forward, m.keys.ForwardAll, m.keys.ForwardToPod, m.keys.ForwardLogs,
+			m.keys.Restart, m.keys.PortUp, m.keys.PortDown, m.keys.Palette)
+	case detailView:
+		return key.Matches(msg, m.keys.Forward)
+	case ingressView:
+		return msg.Type == tea.KeyEnter || key.Matches(msg, m.keys.Forward)
+	}
+	return false
+}
+
+// startPendingTick schedules a pendingTick unless one is already running
+// or nothing is pending
+func (m *Model) startPendingTick() tea.Cmd {
+	if m.pendingTicking || !m.table.HasPendingRows() {
+		return nil
+	}
+	m.pendingTicking = true
+	return pendingTick()
+}
+
+// stopAllForwards stops every forward and resets all rows to inactive
+func (m *Model) stopAllForwards() {
+	m.forwardManager.StopAll()
+	m.table.ForEachRow(func(row *ServiceTableRow) {
+		row.ForwardingState = k8s.ForwardingStateInactive
+		row.ForwardingPort = 0
+		if row.PortInfo != nil {
+			row.PortInfo.ForwardingState = k8s.ForwardingStateInactive
+			row.PortInfo.ForwardingPort = 0
+		}
+	})
+	atomic.StoreInt64(&m.activeForwardsCount, 0)
+	m.forwardSummary = portforward.Summary{}
+}
+
 // applyFilters applies the active filters to the service table
 func (m *Model) applyFilters() {
 	m.table.ApplyFilters(m.activeFilters)
 }
 
+// updateSearch handles a key typed into the quick search prompt, narrowing
+// the table by name on every keystroke. Enter keeps the filter, Esc clears it.
+func (m *Model) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.searching = false
+		return nil
+	case tea.KeyEsc:
+		m.searching = false
+		m.searchInput = ""
+	case tea.KeyBackspace:
+		if runes := []rune(m.searchInput); len(runes) > 0 {
+			m.searchInput = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.searchInput += string(msg.Runes)
+	default:
+		return nil
+	}
+
+	if m.searchInput == "" {
+		delete(m.activeFilters, "name")
+	} else {
+		m.activeFilters["name"] = m.searchInput
+	}
+	m.applyFilters()
+	return nil
+}
+
 // getFilterSuggestions returns available suggestions for the given filter type
 func (m *Model) getFilterSuggestions(filterType string) []string {
 	switch filterType {
@@ -1250,6 +2893,19 @@ func (m *Model) getFilterSuggestions(filterType string) []string {
 		sort.Strings(names)
 		return names
 		
+	case "namespace":
+		// Get unique namespaces from current services
+		nsSet := make(map[string]bool)
+		for _, svc := range m.table.services {
+			nsSet[svc.Namespace] = true
+		}
+		var namespaces []string
+		for ns := range nsSet {
+			namespaces = append(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+		return namespaces
+		
 	case "protocol":
 		// Get unique protocols from current services
 		protoSet := make(map[string]bool)
@@ -1264,6 +2920,9 @@ func (m *Model) getFilterSuggestions(filterType string) []string {
 		}
 		sort.Strings(protocols)
 		return protocols
+
+	case "forwardable":
+		return []string{"true", "false"}
 		
 	default:
 		return []string{}
@@ -1304,9 +2963,13 @@ func (m *Model) renderFilterView() string {
 	case "type":
 		content += "Enter service type: "
 	case "name":
-		content += "Enter name filter (partial match): "
+		content += "Enter name filter (partial match, /regex): "
+	case "namespace":
+		content += "Enter namespace: "
 	case "protocol":
 		content += "Enter protocol filter: "
+	case "forwardable":
+		content += "Show forwardable services (true/false): "
 	default:
 		content += "Enter search term (searches all fields): "
 	}
@@ -1319,6 +2982,12 @@ func (m *Model) renderFilterView() string {
 		inputBox += "_"
 	}
 	content += inputBox + "\n\n"
+
+	if m.filterType == "name" {
+		if _, err := compileNameFilter(m.filterInput); err != nil {
+			content += errorStyle.Render("invalid regex, falling back to substring match") + "\n\n"
+		}
+	}
 	
 	// Show suggestions if filter type is selected and has completions available
 	if m.filterType != "" {
@@ -1367,3 +3036,225 @@ func (m *Model) renderFilterView() string {
 	return RenderWithFooter(content, footerText, m.width, m.height)
 }
 
+
+// renderPodPickerView renders the list of pods the selected port can be forwarded to
+func (m *Model) renderPodPickerView() string {
+	header := m.renderAdminHeader()
+
+	selectedRow := m.table.GetSelectedRow()
+	if selectedRow == nil || selectedRow.PortInfo == nil {
+		return "Error: No service selected"
+	}
+
+	content := header + sectionHeaderStyle.Render("Select Pod") + "\n\n"
+	content += fmt.Sprintf("Forward %s/%s:%d through pod:\n\n",
+		selectedRow.ServiceData.Namespace, selectedRow.ServiceData.Name, selectedRow.PortInfo.Port)
+
+	if m.pickerPod != "" {
+		content = header + sectionHeaderStyle.Render("Select Container") + "\n\n"
+		content += fmt.Sprintf("Containers of pod %s declaring port %q:\n\n", m.pickerPod, selectedRow.PortInfo.TargetPortName)
+		for i, choice := range m.pickerContainers {
+			line := fmt.Sprintf("%-50s %d", choice.name, choice.port)
+			if i == 0 {
+				line += " (default)"
+			}
+			if i == m.pickerContainerIndex {
+				content += adminSelectedRowStyle.Render("> "+line) + "\n"
+			} else {
+				content += adminNormalRowStyle.Render("  "+line) + "\n"
+			}
+		}
+		return RenderWithFooter(content, "↑↓/jk:select enter:forward esc:back to pods", m.width, m.height)
+	}
+
+	switch {
+	case !m.pickerLoaded:
+		content += "Loading pods...\n"
+	case m.pickerErr != nil:
+		content += errorStyle.Render(m.pickerErr.Error()) + "\n"
+	case len(m.pickerPods) == 0 && m.pickerNamespaceWide:
+		content += fmt.Sprintf("No pods found for this service or in namespace %s\n", selectedRow.ServiceData.Namespace)
+	case len(m.pickerPods) == 0:
+		content += "No pods found for this service\n"
+	default:
+		if m.pickerNamespaceWide {
+			content += staleStyle.Render(fmt.Sprintf("The service has no pods, these are all pods of namespace %s; the one picked must listen on the target port itself", selectedRow.ServiceData.Namespace)) + "\n\n"
+		}
+		for i, pod := range m.pickerPods {
+			ready := "ready"
+			if !pod.Ready {
+				ready = "not ready"
+			}
+			line := fmt.Sprintf("%-50s %-10s %-10s %d restarts", pod.Name, pod.Phase, ready, pod.Restarts)
+			if i == m.pickerIndex {
+				content += adminSelectedRowStyle.Render("> "+line) + "\n"
+			} else {
+				content += adminNormalRowStyle.Render("  "+line) + "\n"
+			}
+		}
+	}
+
+	footerText := "↑↓/jk:select enter:forward esc:cancel"
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
+
+// renderContextView renders the kubeconfig context switcher
+func (m *Model) renderContextView() string {
+	header := m.renderAdminHeader()
+
+	content := header + sectionHeaderStyle.Render("Switch Context") + "\n\n"
+	if count := len(m.forwardManager.GetAllForwards()); count > 0 {
+		content += errorStyle.Render(fmt.Sprintf("Switching contexts will stop %d active forwards", count)) + "\n\n"
+	}
+
+	current := m.client.CurrentContext()
+	switch {
+	case !m.contextsLoaded:
+		content += "Loading contexts...\n"
+	case m.contextsErr != nil:
+		content += errorStyle.Render(m.contextsErr.Error()) + "\n"
+	case len(m.contexts) == 0:
+		content += "No contexts found in kubeconfig\n"
+	default:
+		for i, name := range m.contexts {
+			line := name
+			if name == current {
+				line += " (current)"
+			}
+			if i == m.contextIndex {
+				content += adminSelectedRowStyle.Render("> "+line) + "\n"
+			} else {
+				content += adminNormalRowStyle.Render("  "+line) + "\n"
+			}
+		}
+	}
+
+	footerText := "↑↓/jk:select enter:switch esc:cancel"
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
+
+// clampForwardsIndex keeps the forwards view's cursor on a forward after the
+// list changed
+func (m *Model) clampForwardsIndex() {
+	if m.viewMode != forwardsView {
+		return
+	}
+	if count := len(m.forwardManager.GetAllForwards()); m.forwardsIndex >= count {
+		m.forwardsIndex = count - 1
+	}
+	if m.forwardsIndex < 0 {
+		m.forwardsIndex = 0
+	}
+}
+
+// sortedForwards returns all forwards known to the manager ordered by key
+func (m *Model) sortedForwards() []portforward.ForwardInfo {
+	all := m.forwardManager.GetAllForwards()
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	forwards := make([]portforward.ForwardInfo, 0, len(keys))
+	for _, k := range keys {
+		forwards = append(forwards, *all[k])
+	}
+	return forwards
+}
+
+// renderForwardsView renders an overview of every forward with the option to stop one
+func (m *Model) renderForwardsView() string {
+	header := m.renderAdminHeader()
+	sectionHeaders := m.renderSectionHeaders()
+
+	forwards := m.sortedForwards()
+
+	headerLine := fmt.Sprintf("%-35s %-30s %-8s %-12s %-10s %-8s", "NAMESPACE", "SERVICE", "PORT", "LOCAL-PORT", "STATE", "AGE")
+	content := header + sectionHeaders + adminTableHeaderStyle.Width(m.width).Render(headerLine) + "\n\n"
+
+	if len(forwards) == 0 {
+		content += "No forwards\n"
+	}
+	for i, fw := range forwards {
+		localPort := ""
+		if fw.LocalPort > 0 {
+			localPort = fmt.Sprintf(":%d", fw.LocalPort)
+		}
+		line := fmt.Sprintf("%-35s %-30s %-8d %-12s %-10s %-8s",
+			truncateString(fw.Namespace, 35), truncateString(fw.Service, 30), fw.RemotePort, localPort,
+			fw.ForwardingState.String(), formatAge(time.Since(fw.StartedAt)))
+		if i == m.forwardsIndex {
+			content += adminSelectedRowStyle.Render(line) + "\n"
+		} else {
+			content += adminNormalRowStyle.Render(line) + "\n"
+		}
+	}
+
+	// Local ports kpf holds, so new forwards can avoid them
+	if allocated := portforward.AllocatedPortsOf(m.forwardManager.GetAllForwards()); len(allocated) > 0 {
+		ports := make([]int, 0, len(allocated))
+		for port := range allocated {
+			ports = append(ports, port)
+		}
+		sort.Ints(ports)
+		entries := make([]string, 0, len(ports))
+		for _, port := range ports {
+			entries = append(entries, fmt.Sprintf(":%d → %s", port, allocated[port]))
+		}
+		content += "\n" + detailLabelStyle.Render("Allocated local ports") + ": " + strings.Join(entries, ", ") + "\n"
+	}
+
+	footerText := "↑↓/jk:select x/enter:stop v/esc:back"
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
+
+// renderIngressView lists ingress host/path to service mappings
+func (m *Model) renderIngressView() string {
+	header := m.renderAdminHeader()
+	sectionHeaders := m.renderSectionHeaders()
+
+	headerLine := fmt.Sprintf("%-25s %-35s %-25s %-40s %-10s", "NAMESPACE", "HOST", "PATH", "BACKEND", "FORWARD")
+	content := header + sectionHeaders + adminTableHeaderStyle.Width(m.width).Render(headerLine) + "\n\n"
+
+	switch {
+	case !m.ingressesLoaded:
+		content += "Loading ingresses...\n"
+	case m.ingressesErr != nil:
+		content += errorStyle.Render(m.ingressesErr.Error()) + "\n"
+	case len(m.ingresses) == 0:
+		content += "No ingresses found\n"
+	default:
+		for i, ing := range m.ingresses {
+			backend := ing.Backend
+			forward := ""
+			if ing.HasService() {
+				if ing.ServicePort != 0 {
+					backend = fmt.Sprintf("%s:%d", ing.ServiceName, ing.ServicePort)
+					if fw := m.forwardManager.GetForwardInfo(ing.Namespace, ing.ServiceName, int(ing.ServicePort)); fw != nil {
+						forward = fw.ForwardingState.String()
+						if fw.ForwardingState == k8s.ForwardingStateActive {
+							forward = fmt.Sprintf(":%d", fw.LocalPort)
+						}
+					}
+				} else {
+					backend = fmt.Sprintf("%s:%s", ing.ServiceName, ing.ServicePortName)
+				}
+			}
+
+			line := fmt.Sprintf("%-25s %-35s %-25s %-40s %-10s",
+				truncateString(ing.Namespace, 25), truncateString(ing.Host, 35), truncateString(ing.Path, 25),
+				truncateString(backend, 40), forward)
+			if i == m.ingressIndex {
+				content += adminSelectedRowStyle.Render(line) + "\n"
+			} else if !ing.HasService() {
+				content += inactiveStyle.Render(line) + "\n"
+			} else {
+				content += adminNormalRowStyle.Render(line) + "\n"
+			}
+		}
+	}
+
+	footerText := "↑↓/jk:select enter/f:forward-backend r:refresh i/esc:back"
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
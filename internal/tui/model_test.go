@@ -0,0 +1,183 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+// fakeForwarder is a Forwarder whose forwards become active right away, on
+// the preferred local port or else the next one from 20000
+type fakeForwarder struct {
+	mu       sync.Mutex
+	forwards map[string]*portforward.ForwardInfo
+	nextPort int
+}
+
+func newFakeForwarder() *fakeForwarder {
+	return &fakeForwarder{forwards: make(map[string]*portforward.ForwardInfo), nextPort: 20000}
+}
+
+func fakeKey(namespace, serviceName string, remotePort int) string {
+	return fmt.Sprintf("%s/%s:%d", namespace, serviceName, remotePort)
+}
+
+func (f *fakeForwarder) StartForwardToContainer(ctx context.Context, namespace, serviceName, podName, container string, remotePort, preferredLocalPort int) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := fakeKey(namespace, serviceName, remotePort)
+	if _, exists := f.forwards[key]; exists {
+		return 0, portforward.ErrAlreadyForwarding
+	}
+	localPort := preferredLocalPort
+	if localPort == 0 {
+		localPort = f.nextPort
+		f.nextPort++
+	}
+	f.forwards[key] = &portforward.ForwardInfo{
+		Namespace:       namespace,
+		Service:         serviceName,
+		RemotePort:      remotePort,
+		LocalPort:       localPort,
+		PodName:         podName,
+		ForwardingState: k8s.ForwardingStateActive,
+	}
+	return localPort, nil
+}
+
+func (f *fakeForwarder) RestartForward(ctx context.Context, namespace, serviceName string, remotePort int) (int, error) {
+	fw := f.GetForwardInfo(namespace, serviceName, remotePort)
+	if fw == nil {
+		return 0, fmt.Errorf("%s is not being forwarded", fakeKey(namespace, serviceName, remotePort))
+	}
+	f.StopForward(namespace, serviceName, remotePort)
+	return f.StartForwardToContainer(ctx, namespace, serviceName, "", "", remotePort, fw.LocalPort)
+}
+
+func (f *fakeForwarder) CancelPending(namespace, serviceName string, remotePort int) bool {
+	return false
+}
+
+func (f *fakeForwarder) StopForward(namespace, serviceName string, remotePort int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.forwards, fakeKey(namespace, serviceName, remotePort))
+}
+
+func (f *fakeForwarder) StopAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forwards = make(map[string]*portforward.ForwardInfo)
+}
+
+func (f *fakeForwarder) GetForwardInfo(namespace, serviceName string, remotePort int) *portforward.ForwardInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if fw, ok := f.forwards[fakeKey(namespace, serviceName, remotePort)]; ok {
+		copy := *fw
+		return &copy
+	}
+	return nil
+}
+
+func (f *fakeForwarder) GetAllForwards() map[string]*portforward.ForwardInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	result := make(map[string]*portforward.ForwardInfo, len(f.forwards))
+	for key, fw := range f.forwards {
+		copy := *fw
+		result[key] = &copy
+	}
+	return result
+}
+
+// newTestModel returns a model listing one service per name, each with a
+// single TCP port 80, backed by forwarder
+func newTestModel(t *testing.T, forwarder portforward.Forwarder, names ...string) *Model {
+	t.Helper()
+	// Keep the model from reading the user's saved state and preferences
+	t.Setenv("HOME", t.TempDir())
+
+	var services []k8s.ServiceInfo
+	for _, name := range names {
+		services = append(services, k8s.ServiceInfo{
+			Name:      name,
+			Namespace: "default",
+			Type:      "ClusterIP",
+			Ports:     []k8s.PortInfo{{Name: "http", Port: 80, Protocol: "TCP"}},
+		})
+	}
+
+	m := NewModel(nil, forwarder, "", "default", "", Options{})
+	m.table.SetServices(services)
+	return m
+}
+
+// selectService moves the cursor to the row of the named service
+func selectService(t *testing.T, m *Model, name string) {
+	t.Helper()
+	for i := 0; i < m.table.GetRowCount(); i++ {
+		m.table.SetSelected(i)
+		if m.table.GetSelectedRow().Name == name {
+			return
+		}
+	}
+	t.Fatalf("no row for service %s", name)
+}
+
+// rowFor returns the current row of the named service
+func rowFor(t *testing.T, m *Model, name string) ServiceTableRow {
+	t.Helper()
+	for _, row := range m.table.getActiveRows() {
+		if row.Name == name {
+			return row
+		}
+	}
+	t.Fatalf("no row for service %s", name)
+	return ServiceTableRow{}
+}
+
+func TestStartPortForwardStartsSelectedPort(t *testing.T) {
+	forwarder := newFakeForwarder()
+	m := newTestModel(t, forwarder, "api", "web")
+	selectService(t, m, "web")
+
+	msg, ok := m.startPortForward().(portForwardStartedMsg)
+	if !ok {
+		t.Fatalf("got %T, want portForwardStartedMsg", msg)
+	}
+	if msg.service != "web" || msg.port != 80 || msg.localPort != 80 {
+		t.Errorf("got %+v, want web:80 on local port 80", msg)
+	}
+	if forwarder.GetForwardInfo("default", "web", 80) == nil {
+		t.Error("forwarder has no forward for web:80")
+	}
+	if forwarder.GetForwardInfo("default", "api", 80) != nil {
+		t.Error("forwarder forwards api:80, which wasn't selected")
+	}
+}
+
+func TestForwardStartedMarksItsRowAfterCursorMoved(t *testing.T) {
+	forwarder := newFakeForwarder()
+	m := newTestModel(t, forwarder, "api", "web")
+	selectService(t, m, "api")
+
+	msg := m.startPortForward()
+	selectService(t, m, "web")
+	m.Update(msg)
+
+	if row := rowFor(t, m, "api"); row.ForwardingState != k8s.ForwardingStateActive || row.ForwardingPort != 80 {
+		t.Errorf("api row is %v on port %d, want active on port 80", row.ForwardingState, row.ForwardingPort)
+	}
+	if row := rowFor(t, m, "web"); row.ForwardingState != k8s.ForwardingStateInactive || row.ForwardingPort != 0 {
+		t.Errorf("web row is %v on port %d, want inactive", row.ForwardingState, row.ForwardingPort)
+	}
+	if got := m.forwardSummary.Active; got != 1 {
+		t.Errorf("summary counts %d active forwards, want 1", got)
+	}
+}
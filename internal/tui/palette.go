@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grumpylabs/kpf/internal/portforward"
+)
+
+// paletteSuggestionsShown caps the suggestions listed under the palette input
+const paletteSuggestionsShown = 10
+
+// openPalette switches to the command palette with an empty input
+func (m *Model) openPalette() {
+	m.viewMode = paletteView
+	m.paletteInput = ""
+	m.paletteMatches = nil
+	m.paletteCompletion = -1
+	m.paletteErr = nil
+}
+
+// getPaletteSuggestions returns the namespace/service:port of every loaded
+// service port containing input
+func (m *Model) getPaletteSuggestions(input string) []string {
+	input = strings.ToLower(input)
+
+	var suggestions []string
+	for _, svc := range m.table.services {
+		for _, port := range svc.Ports {
+			spec := fmt.Sprintf("%s/%s:%d", svc.Namespace, svc.Name, port.Port)
+			if strings.Contains(strings.ToLower(spec), input) {
+				suggestions = append(suggestions, spec)
+			}
+		}
+	}
+	sort.Strings(suggestions)
+	return suggestions
+}
+
+// updatePalette handles a key press in the command palette. Tab cycles
+// through the service ports matching what was typed, enter forwards.
+func (m *Model) updatePalette(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.viewMode = listView
+		return nil
+
+	case tea.KeyEnter:
+		sf, err := portforward.ParseForwardSpec(strings.TrimSpace(m.paletteInput))
+		if err != nil {
+			m.paletteErr = err
+			return nil
+		}
+		m.viewMode = listView
+		m.statusMessage = fmt.Sprintf("Forwarding %s/%s:%d...", sf.Namespace, sf.Service, sf.RemotePort)
+		return m.startPaletteForward(sf)
+
+	case tea.KeyTab:
+		if m.paletteMatches == nil {
+			m.paletteMatches = m.getPaletteSuggestions(m.paletteInput)
+			m.paletteCompletion = -1
+		}
+		if len(m.paletteMatches) > 0 {
+			m.paletteCompletion = (m.paletteCompletion + 1) % len(m.paletteMatches)
+			m.paletteInput = m.paletteMatches[m.paletteCompletion]
+		}
+		return nil
+
+	case tea.KeyBackspace:
+		if runes := []rune(m.paletteInput); len(runes) > 0 {
+			m.paletteInput = string(runes[:len(runes)-1])
+		}
+
+	case tea.KeyRunes:
+		m.paletteInput += string(msg.Runes)
+
+	default:
+		return nil
+	}
+
+	// Editing starts a new completion from what was typed
+	m.paletteMatches = nil
+	m.paletteCompletion = -1
+	m.paletteErr = nil
+	return nil
+}
+
+// startPaletteForward forwards a service port typed into the palette. The
+// port is most likely not the selected row, so the result is reported in the
+// status line and a taken local port falls back to a free one instead of
+// prompting.
+func (m *Model) startPaletteForward(sf portforward.SavedForward) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := m.forwardContext()
+		defer cancel()
+
+		localPort := sf.LocalPort
+		if localPort == 0 {
+			localPort = m.preferredLocalPort(sf.Namespace, sf.Service, sf.RemotePort)
+		}
+
+		target := fmt.Sprintf("%s/%s:%d", sf.Namespace, sf.Service, sf.RemotePort)
+		localPort, err := m.startForward(ctx, sf.Namespace, sf.Service, sf.RemotePort, localPort)
+		if err != nil && strings.Contains(err.Error(), "already in use") {
+			m.forwardManager.StopForward(sf.Namespace, sf.Service, sf.RemotePort)
+			localPort, err = m.startForward(ctx, sf.Namespace, sf.Service, sf.RemotePort, 0)
+		}
+		if err != nil {
+			return bulkForwardMsg{summary: fmt.Sprintf("Failed to forward %s: %v", target, err)}
+		}
+		return bulkForwardMsg{summary: fmt.Sprintf("Forwarding %s → localhost:%d", target, localPort)}
+	}
+}
+
+// renderPaletteView renders the command palette
+func (m *Model) renderPaletteView() string {
+	header := m.renderAdminHeader()
+
+	content := header + sectionHeaderStyle.Render("Forward Service Port") + "\n\n"
+	content += "Forward (namespace/service:port[:localPort]): " + m.paletteInput + "_\n\n"
+
+	if m.paletteErr != nil {
+		content += errorStyle.Render(m.paletteErr.Error()) + "\n\n"
+	}
+
+	matches := m.paletteMatches
+	if matches == nil && m.paletteInput != "" {
+		matches = m.getPaletteSuggestions(m.paletteInput)
+	}
+	// Scroll the list along with the tab completion
+	start := 0
+	if m.paletteCompletion >= paletteSuggestionsShown {
+		start = m.paletteCompletion - paletteSuggestionsShown + 1
+	}
+	end := min(start+paletteSuggestionsShown, len(matches))
+	for i := start; i < end; i++ {
+		match := matches[i]
+		if i == m.paletteCompletion {
+			content += adminSelectedRowStyle.Render("> "+match) + "\n"
+		} else {
+			content += adminNormalRowStyle.Render("  "+match) + "\n"
+		}
+	}
+	if more := len(matches) - end; more > 0 {
+		content += fmt.Sprintf("  ... %d more\n", more)
+	}
+
+	footerText := "tab:complete enter:forward esc:cancel"
+	return RenderWithFooter(content, footerText, m.width, m.height)
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// preferences are the UI settings remembered between sessions
+type preferences struct {
+	SortField     string `json:"sortField"`
+	SortAscending bool   `json:"sortAscending"`
+}
+
+// sortFields are the fields the table can be sorted by
+var sortFields = map[string]bool{
+	"namespace": true,
+	"name":      true,
+	"status":    true,
+	"ports":     true,
+	"localport": true,
+	"age":       true,
+	"started":   true,
+	"failed":    true,
+}
+
+// defaultPreferencesPath returns the file the UI preferences are kept in
+func defaultPreferencesPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "kpf", "preferences.json")
+}
+
+// loadPreferences reads the preferences saved at path. A missing file is not an error.
+func loadPreferences(path string) (preferences, error) {
+	prefs := preferences{SortField: "namespace", SortAscending: true}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return prefs, nil
+		}
+		return prefs, fmt.Errorf("failed to read preferences: %w", err)
+	}
+
+	var saved preferences
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return prefs, fmt.Errorf("failed to decode preferences: %w", err)
+	}
+	if sortFields[saved.SortField] {
+		prefs = saved
+	}
+	return prefs, nil
+}
+
+// savePreferences writes prefs to path
+func savePreferences(path string, prefs preferences) error {
+	data, err := json.MarshalIndent(prefs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode preferences: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write preferences: %w", err)
+	}
+	return nil
+}
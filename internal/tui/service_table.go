@@ -2,11 +2,15 @@ package tui
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/grumpylabs/kpf/internal/k8s"
+	"github.com/grumpylabs/kpf/internal/portforward"
+	"github.com/mattn/go-runewidth"
 )
 
 // ServiceTableRow represents a row in the service table
@@ -20,9 +24,15 @@ type ServiceTableRow struct {
 	Port           int32
 	Protocol       string
 	Age            string
+	CreatedAt      time.Time
 	ForwardingState k8s.ForwardingState
 	ForwardingPort  int
+	PlannedPort     int // Local port configured with --local-port-map, 0 if none
 	Selected       bool
+	// Collapsed rows stand in for all ports of a service, summarized
+	Collapsed      bool
+	PortCount      int
+	ActiveCount    int
 	// Complete service data duplicated per row
 	ServiceData    k8s.ServiceInfo  // Complete copy of service data
 	PortInfo       *k8s.PortInfo    // Reference to specific port within ServiceData
@@ -38,6 +48,20 @@ type ServiceTable struct {
 	services     []k8s.ServiceInfo
 	offset       int // For scrolling
 	filters      map[string]string // Active filters
+	nameRegex    *regexp.Regexp    // Compiled name filter when it uses the /pattern form
+	compact      bool              // Show only namespace, name, port and status
+	colorByNS    bool              // Color the namespace column per namespace
+	localPorts   portforward.LocalPorts // Configured local ports, shown dimmed on inactive rows
+
+	// Collapse mode shows services with several ports as one row, unless
+	// they were expanded
+	collapsed     bool
+	expanded      map[string]bool
+	collapsedRows []ServiceTableRow
+
+	// Service label whose value groups the rows under section headers,
+	// no grouping if empty
+	groupLabel string
 }
 
 // NewServiceTable creates a new service table
@@ -55,6 +79,10 @@ func NewServiceTable() *ServiceTable {
 
 // SetServices updates the table with new service data, creating one row per port
 func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
+	// Remember what was selected and visible before the rows are rebuilt
+	selectedKey := t.selectedKey()
+	previousKeys := rowKeys(t.getActiveRows())
+
 	t.services = services
 	
 	// Calculate total rows needed (one per port)
@@ -73,9 +101,10 @@ func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
 		svc := services[i]
 		// Get service age
 		age := "unknown"
+		var createdAt time.Time
 		if svc.Service != nil && !svc.Service.CreationTimestamp.IsZero() {
-			duration := time.Since(svc.Service.CreationTimestamp.Time)
-			age = formatAge(duration)
+			createdAt = svc.Service.CreationTimestamp.Time
+			age = formatAge(time.Since(createdAt))
 		}
 
 		// Get cluster IP
@@ -86,16 +115,8 @@ func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
 
 		// Get external IP
 		externalIP := "<none>"
-		if svc.Service != nil {
-			if len(svc.Service.Status.LoadBalancer.Ingress) > 0 {
-				if svc.Service.Status.LoadBalancer.Ingress[0].IP != "" {
-					externalIP = svc.Service.Status.LoadBalancer.Ingress[0].IP
-				} else if svc.Service.Status.LoadBalancer.Ingress[0].Hostname != "" {
-					externalIP = svc.Service.Status.LoadBalancer.Ingress[0].Hostname
-				}
-			} else if len(svc.Service.Spec.ExternalIPs) > 0 {
-				externalIP = svc.Service.Spec.ExternalIPs[0]
-			}
+		if addresses := externalAddresses(svc); len(addresses) > 0 {
+			externalIP = addresses[0]
 		}
 
 		if len(svc.Ports) == 0 {
@@ -110,6 +131,7 @@ func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
 				Port:            0,
 				Protocol:        "",
 				Age:             age,
+				CreatedAt:       createdAt,
 				ForwardingState: k8s.ForwardingStateInactive,
 				ForwardingPort:  0,
 				Selected:        false, // Will be set properly in SetSelected
@@ -136,8 +158,10 @@ func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
 					Port:           port.Port,
 					Protocol:       port.Protocol,
 					Age:            age,
+					CreatedAt:      createdAt,
 					ForwardingState: port.ForwardingState,
 					ForwardingPort:  port.ForwardingPort,
+					PlannedPort:     t.localPorts[portforward.LocalPortKey(svc.Namespace, svc.Name, int(port.Port))],
 					Selected:       false, // Will be set properly in SetSelected
 					ServiceData:    svc,  // Copy complete service data
 					PortInfo:       port,
@@ -147,57 +171,16 @@ func (t *ServiceTable) SetServices(services []k8s.ServiceInfo) {
 		}
 	}
 	
-	// Store the currently selected service/port info before applying filters
-	var selectedServiceKey string
-	if t.selectedRow >= 0 && t.selectedRow < len(t.getActiveRows()) {
-		currentRows := t.getActiveRows()
-		if len(currentRows) > 0 {
-			selected := currentRows[t.selectedRow]
-			selectedServiceKey = fmt.Sprintf("%s/%s:%d", selected.Namespace, selected.Name, selected.Port)
-		}
-	}
-	
-	// Apply existing filters
-	t.ApplyFilters(t.filters)
-	
-	// Try to restore selection to the same service/port
-	newSelectedRow := 0
-	if selectedServiceKey != "" {
-		rows := t.getActiveRows()
-		for i, row := range rows {
-			rowKey := fmt.Sprintf("%s/%s:%d", row.Namespace, row.Name, row.Port)
-			if rowKey == selectedServiceKey {
-				newSelectedRow = i
-				break
-			}
-		}
-	}
-	
-	// Ensure valid row is selected
-	rows := t.getActiveRows()
-	if newSelectedRow >= len(rows) {
-		newSelectedRow = len(rows) - 1
-	}
-	if newSelectedRow < 0 {
-		newSelectedRow = 0
-	}
-	t.selectedRow = newSelectedRow
-	
-	// Adjust scroll offset to keep selected row visible
-	t.adjustScrollOffset()
-	
-	// Set selection on the current row
-	if len(rows) > 0 && t.selectedRow >= 0 && t.selectedRow < len(rows) {
-		for i := range rows {
-			rows[i].Selected = (i == t.selectedRow)
-		}
-	}
+	// Re-apply existing filters, restoring the selection to the same service/port
+	t.applyFilters(t.filters, selectedKey, previousKeys)
 }
 
 // SetSize sets the table dimensions
 func (t *ServiceTable) SetSize(width, height int) {
 	t.width = width
 	t.height = height
+	// Keep the selection visible when the table shrinks
+	t.adjustScrollOffset()
 }
 
 // SetSelected sets the selected row
@@ -271,6 +254,21 @@ func (t *ServiceTable) MoveDown() {
 	}
 }
 
+// SelectNextActive moves the selection to the next row with an active forward,
+// or the previous one when step is negative, wrapping around. It reports
+// whether there was such a row.
+func (t *ServiceTable) SelectNextActive(step int) bool {
+	rows := t.getActiveRows()
+	for i := 1; i <= len(rows); i++ {
+		index := ((t.selectedRow+i*step)%len(rows) + len(rows)) % len(rows)
+		if rows[index].ForwardingState == k8s.ForwardingStateActive {
+			t.SetSelected(index)
+			return true
+		}
+	}
+	return false
+}
+
 // adjustScrollOffset adjusts the scroll offset to keep the selected row visible
 func (t *ServiceTable) adjustScrollOffset() {
 	if t.height <= 3 { // Need space for header + blank line + at least one row
@@ -280,16 +278,25 @@ func (t *ServiceTable) adjustScrollOffset() {
 	// Available rows = height - 2 (header + blank line)
 	availableRows := t.height - 2
 	
-	// Make sure selected row is visible
-	if t.selectedRow < t.offset {
-		t.offset = t.selectedRow
-	} else if t.selectedRow >= t.offset+availableRows {
-		t.offset = t.selectedRow - availableRows + 1
+	// Make sure selected row is visible, with the header of its group if
+	// it is the first row of the group
+	lines := t.bodyLines(t.getActiveRows())
+	selectedLine := lineOfRow(lines, t.selectedRow)
+	if selectedLine < 0 {
+		return
+	}
+	top := selectedLine
+	if top > 0 && lines[top-1].row < 0 {
+		top--
+	}
+	if top < t.offset {
+		t.offset = top
+	} else if selectedLine >= t.offset+availableRows {
+		t.offset = selectedLine - availableRows + 1
 	}
 	
 	// Don't scroll past the end
-	rows := t.getActiveRows()
-	maxOffset := len(rows) - availableRows
+	maxOffset := len(lines) - availableRows
 	if maxOffset < 0 {
 		maxOffset = 0
 	}
@@ -310,9 +317,17 @@ func (t *ServiceTable) Render() string {
 	
 	var content strings.Builder
 	
+	// Format every active row up front, the wide layout sizes its columns
+	// by their content
+	activeRows := t.getActiveRows()
+	cells := make([][]string, len(activeRows))
+	for i, row := range activeRows {
+		cells[i] = t.rowCells(row)
+	}
+
 	// Render header row with full width background
-	headerLine := fmt.Sprintf("%-35s %-30s %-4s %-16s %-16s %-15s %-18s %-12s",
-		"NAMESPACE", "NAME", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORT-NAME", "PORT", "LOCAL-PORT")
+	headers, widths := t.layout(cells)
+	headerLine := formatColumns(widths, headers...)
 	
 	// Ensure header background extends to full terminal width
 	headerWithBg := adminTableHeaderStyle.Width(t.width).Render(headerLine)
@@ -325,14 +340,17 @@ func (t *ServiceTable) Render() string {
 		return content.String()
 	}
 	
-	// Render visible data rows
-	activeRows := t.getActiveRows()
-	endIndex := t.offset + availableRows
-	if endIndex > len(activeRows) {
-		endIndex = len(activeRows)
-	}
+	// Render visible data rows and group headers
+	lines := t.bodyLines(activeRows)
+	startIndex := min(t.offset, len(lines))
+	endIndex := min(startIndex+availableRows, len(lines))
 	
-	for i := t.offset; i < endIndex; i++ {
+	for _, bodyLine := range lines[startIndex:endIndex] {
+		if bodyLine.row < 0 {
+			content.WriteString(t.renderGroupHeader(bodyLine) + "\n")
+			continue
+		}
+		i := bodyLine.row
 		row := activeRows[i]
 		
 		// Status indicator
@@ -344,7 +362,7 @@ func (t *ServiceTable) Render() string {
 			statusIndicator = "●" // Green filled circle for active
 			coloredIndicator = activeStyle.Render(statusIndicator)
 		case k8s.ForwardingStatePending:
-			statusIndicator = "●" // Yellow filled circle for pending
+			statusIndicator = pendingSpinner() // Yellow spinner for pending
 			coloredIndicator = pendingStyle.Render(statusIndicator)
 		case k8s.ForwardingStateFailed:
 			statusIndicator = "●" // Red filled circle for failed
@@ -353,38 +371,28 @@ func (t *ServiceTable) Render() string {
 			coloredIndicator = inactiveStyle.Render(statusIndicator)
 		}
 		
-		// Truncate fields to fit column widths
-		namespace := row.Namespace
-		name := truncateString(row.Name, 30)
-		clusterIP := truncateString(row.ClusterIP, 16)
-		externalIP := truncateString(row.ExternalIP, 16)
-		portName := truncateString(row.PortName, 15)
-		port := ""
-		if row.Port > 0 {
-			if row.Protocol != "" {
-				port = fmt.Sprintf("%d/%s", row.Port, row.Protocol)
-			} else {
-				port = fmt.Sprintf("%d", row.Port)
-			}
-		}
-		port = truncateString(port, 18)
-		
-		// Local port column
-		localPort := ""
-		if row.ForwardingState == k8s.ForwardingStateActive {
-			localPort = fmt.Sprintf(":%d", row.ForwardingPort)
-		}
-		localPort = truncateString(localPort, 12)
-		
-		line := fmt.Sprintf("%-35s %-30s %-4s %-16s %-16s %-15s %-18s %-12s",
-			namespace, name, compactServiceType(row.Type), clusterIP, externalIP, portName, port, localPort)
+		_, noEndpoints := endpointsColumn(row.ServiceData)
+		line := formatColumns(widths, cells[i]...)
 		
-		// Apply row styling
+		// Apply row styling, dimming services a forward would fail for
 		var styledLine string
 		if row.Selected {
 			styledLine = adminSelectedRowStyle.Render(line)
+		} else if noEndpoints || !k8s.IsForwardableProtocol(row.Protocol) {
+			styledLine = inactiveStyle.Render(line)
 		} else {
-			styledLine = adminNormalRowStyle.Render(line)
+			cellStyles := map[int]lipgloss.Style{}
+			if t.colorByNS {
+				// The namespace column is the first one in both layouts
+				cellStyles[0] = namespaceStyle(row.Namespace)
+			}
+			if row.ForwardingState == k8s.ForwardingStateInactive && row.PlannedPort > 0 {
+				cellStyles[t.localPortColumn()] = inactiveStyle
+			}
+			if !t.compact {
+				cellStyles[schemeColumn] = detailLabelStyle
+			}
+			styledLine = renderCells(widths, cells[i], adminNormalRowStyle, cellStyles)
 		}
 		
 		content.WriteString(coloredIndicator + " " + styledLine + "\n")
@@ -393,10 +401,317 @@ func (t *ServiceTable) Render() string {
 	return content.String()
 }
 
+// wideColumnHeaders are the columns of the wide layout
+var wideColumnHeaders = []string{"NAMESPACE", "NAME", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORT-NAME", "PORT", "SCHEME", "READY", "LOCAL-PORT", "AGE"}
+
+// compactColumnHeaders are the columns of the compact layout
+var compactColumnHeaders = []string{"NAMESPACE", "NAME", "PORT", "STATUS"}
+
+// SetCompact switches between the wide and the compact layout
+func (t *ServiceTable) SetCompact(compact bool) {
+	t.compact = compact
+}
+
+// SetLocalPorts sets the configured local ports, shown dimmed in the local
+// port column of rows that aren't forwarded yet. Takes effect on the next
+// SetServices.
+func (t *ServiceTable) SetLocalPorts(ports portforward.LocalPorts) {
+	t.localPorts = ports
+}
+
+// localPortColumn returns the index of the column showing the local port
+func (t *ServiceTable) localPortColumn() int {
+	if t.compact {
+		return 3
+	}
+	return 9
+}
+
+// schemeColumn is the index of the wide column showing the scheme badge
+const schemeColumn = 7
+
+// SetColorByNamespace colors the namespace column of each row by a color
+// derived from the namespace name
+func (t *ServiceTable) SetColorByNamespace(enabled bool) {
+	t.colorByNS = enabled
+}
+
+// Compact reports whether the compact layout is used
+func (t *ServiceTable) Compact() bool {
+	return t.compact
+}
+
+// layout returns the column headers and widths of the current layout for
+// rows formatted by rowCells
+func (t *ServiceTable) layout(cells [][]string) ([]string, []int) {
+	if t.compact {
+		return compactColumnHeaders, compactColumnWidths(t.width)
+	}
+	return wideColumnHeaders, wideColumnWidths(t.width, wideColumnHeaders, cells)
+}
+
+// wideColumnShare caps each wide column at a share of the terminal width
+var wideColumnShare = []float64{0.25, 0.3, 0.05, 0.15, 0.15, 0.15, 0.15, 0.08, 0.1, 0.12, 0.1}
+
+// wideColumnMin is how far each wide column may shrink when space is tight
+var wideColumnMin = []int{8, 10, 4, 0, 0, 0, 9, 0, 5, 10, 3}
+
+// wideShrinkOrder lists the wide columns from least to most important, the
+// order they give up space in on narrow terminals: external IP, cluster IP,
+// port name, scheme, age, namespace, name
+var wideShrinkOrder = []int{4, 3, 5, 7, 10, 0, 1}
+
+// wideColumnWidths sizes the wide columns to their widest value, capped by a
+// share of the terminal width, then shrinks the least important columns
+// until the row fits
+func wideColumnWidths(width int, headers []string, cells [][]string) []int {
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = runewidth.StringWidth(header)
+	}
+	for _, row := range cells {
+		for i, cell := range row {
+			if w := runewidth.StringWidth(cell); i < len(widths) && w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	for i := range widths {
+		limit := int(float64(width) * wideColumnShare[i])
+		if headerWidth := runewidth.StringWidth(headers[i]); limit < headerWidth {
+			limit = headerWidth
+		}
+		if widths[i] > limit {
+			widths[i] = limit
+		}
+	}
+
+	// Status indicator and the spaces between columns
+	total := 2 + len(widths) - 1
+	for _, w := range widths {
+		total += w
+	}
+	for _, i := range wideShrinkOrder {
+		if total <= width {
+			break
+		}
+		shrink := total - width
+		if available := widths[i] - wideColumnMin[i]; shrink > available {
+			shrink = available
+		}
+		if shrink > 0 {
+			widths[i] -= shrink
+			total -= shrink
+		}
+	}
+	return widths
+}
+
+// compactColumnWidths splits the terminal width between the compact columns.
+// Port and status get what they need, namespace and name share the rest.
+func compactColumnWidths(width int) []int {
+	const portWidth, statusWidth = 10, 14
+	// Status indicator, then a space between each of the four columns
+	rest := width - 2 - 3 - portWidth - statusWidth
+	namespaceWidth := rest * 2 / 5
+	if namespaceWidth < 8 {
+		namespaceWidth = 8
+	}
+	nameWidth := rest - namespaceWidth
+	if nameWidth < 10 {
+		nameWidth = 10
+	}
+	return []int{namespaceWidth, nameWidth, portWidth, statusWidth}
+}
+
+// rowCells formats the cells of row for the current layout
+func (t *ServiceTable) rowCells(row ServiceTableRow) []string {
+	port := ""
+	if row.Port > 0 {
+		if row.Protocol != "" {
+			port = fmt.Sprintf("%d/%s", row.Port, row.Protocol)
+		} else {
+			port = fmt.Sprintf("%d", row.Port)
+		}
+	}
+
+	// Local port column
+	localPort := ""
+	if row.ForwardingState == k8s.ForwardingStateActive {
+		localPort = fmt.Sprintf(":%d", row.ForwardingPort)
+		// How long the forward has been up, so stale tunnels stand out
+		if row.PortInfo != nil && !row.PortInfo.ForwardStartTime.IsZero() {
+			localPort += " " + formatAge(time.Since(row.PortInfo.ForwardStartTime))
+		}
+	} else if row.ForwardingState == k8s.ForwardingStatePending && row.PortInfo != nil && !row.PortInfo.ForwardStartTime.IsZero() {
+		// Show how long the forward has been establishing
+		localPort = fmt.Sprintf("%.1fs", time.Since(row.PortInfo.ForwardStartTime).Seconds())
+	} else if row.ForwardingState == k8s.ForwardingStateInactive && !k8s.IsForwardableProtocol(row.Protocol) {
+		localPort = "unsupported"
+	} else if row.ForwardingState == k8s.ForwardingStateInactive && row.PlannedPort > 0 {
+		// The port a forward will use, rendered dimmed
+		localPort = fmt.Sprintf(":%d", row.PlannedPort)
+	}
+
+	if row.Collapsed {
+		portSummary := fmt.Sprintf("%d ports", row.PortCount)
+		localPort = ""
+		if row.ActiveCount > 0 {
+			localPort = fmt.Sprintf("%d active", row.ActiveCount)
+		}
+		if t.compact {
+			return []string{row.Namespace, row.Name, portSummary, localPort}
+		}
+		endpoints, _ := endpointsColumn(row.ServiceData)
+		return []string{row.Namespace, row.Name, compactServiceType(row.Type), row.ClusterIP, row.ExternalIP,
+			portSummary, "", "", endpoints, localPort, row.Age}
+	}
+
+	if t.compact {
+		status := localPort
+		if row.ForwardingState == k8s.ForwardingStatePending || row.ForwardingState == k8s.ForwardingStateFailed {
+			status = strings.TrimSpace(row.ForwardingState.String() + " " + localPort)
+		}
+		return []string{row.Namespace, row.Name, port, status}
+	}
+
+	endpoints, _ := endpointsColumn(row.ServiceData)
+	return []string{row.Namespace, row.Name, compactServiceType(row.Type), row.ClusterIP, row.ExternalIP,
+		row.PortName, port, portScheme(row.PortInfo), endpoints, localPort, row.Age}
+}
+
+// namedSchemes maps port names, or their first dash-separated part as in
+// "http-web" or "grpc-api", to the scheme badge shown for them
+var namedSchemes = map[string]string{
+	"http":       "http",
+	"http2":      "http",
+	"web":        "http",
+	"https":      "https",
+	"grpc":       "grpc",
+	"postgres":   "postgres",
+	"postgresql": "postgres",
+	"pg":         "postgres",
+	"mysql":      "mysql",
+	"redis":      "redis",
+	"mongo":      "mongodb",
+	"mongodb":    "mongodb",
+	"amqp":       "amqp",
+	"kafka":      "kafka",
+}
+
+// wellKnownSchemes gives the scheme badge of ports whose name says nothing
+var wellKnownSchemes = map[int32]string{
+	80:    "http",
+	443:   "https",
+	8080:  "http",
+	8443:  "https",
+	5432:  "postgres",
+	3306:  "mysql",
+	6379:  "redis",
+	27017: "mongodb",
+	5672:  "amqp",
+	9092:  "kafka",
+}
+
+// portScheme returns the scheme badge of a port, from its name or else its
+// well-known port number, or "" when it is unknown
+func portScheme(port *k8s.PortInfo) string {
+	if port == nil {
+		return ""
+	}
+	name := strings.ToLower(port.Name)
+	if scheme, ok := namedSchemes[name]; ok {
+		return scheme
+	}
+	if prefix, _, found := strings.Cut(name, "-"); found {
+		if scheme, ok := namedSchemes[prefix]; ok {
+			return scheme
+		}
+	}
+	return wellKnownSchemes[port.Port]
+}
+
+// externalAddresses returns the addresses a service is reachable at from
+// outside the cluster: the load balancer ingress IPs or hostnames, or else the
+// service's external IPs
+func externalAddresses(svc k8s.ServiceInfo) []string {
+	if svc.Service == nil {
+		return nil
+	}
+
+	var addresses []string
+	for _, ingress := range svc.Service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		} else if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		}
+	}
+	if len(addresses) == 0 {
+		addresses = append(addresses, svc.Service.Spec.ExternalIPs...)
+	}
+	return addresses
+}
+
+// endpointsColumn formats the ready/total endpoint count of a service and
+// reports whether it has no endpoints at all
+func endpointsColumn(svc k8s.ServiceInfo) (string, bool) {
+	if !svc.EndpointsKnown || svc.Type == "ExternalName" {
+		return "-", false
+	}
+	if svc.EndpointCount == 0 {
+		return "⚠ 0/0", true
+	}
+	return fmt.Sprintf("%d/%d", svc.ReadyEndpoints, svc.EndpointCount), false
+}
+
+// spinnerFrames animate the status indicator of pending rows
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// pendingSpinner returns the spinner frame for the current time
+func pendingSpinner() string {
+	frame := time.Now().UnixMilli() / pendingTickInterval.Milliseconds()
+	return spinnerFrames[frame%int64(len(spinnerFrames))]
+}
+
+// RowIndexAt returns the index of the row rendered on line y of the table,
+// counting from the table header
+func (t *ServiceTable) RowIndexAt(y int) (int, bool) {
+	line := y - 2 // Header and blank line
+	if line < 0 || line >= t.height-2 {
+		return 0, false
+	}
+	lines := t.bodyLines(t.getActiveRows())
+	index := t.offset + line
+	if index >= len(lines) || lines[index].row < 0 {
+		return 0, false
+	}
+	return lines[index].row, true
+}
+
+// HasServices reports whether the table has any services, filtered or not
+func (t *ServiceTable) HasServices() bool {
+	return len(t.services) > 0
+}
+
+// HasPendingRows reports whether any row is waiting for a forward to become ready
+func (t *ServiceTable) HasPendingRows() bool {
+	for _, row := range t.rows {
+		if row.ForwardingState == k8s.ForwardingStatePending {
+			return true
+		}
+	}
+	return false
+}
+
 // SortBy sorts the table by the specified field
 func (t *ServiceTable) SortBy(field string, ascending bool) {
-	// Sort all rows first
-	sort.Slice(t.rows, func(i, j int) bool {
+	// Remember the selected row so it stays selected after sorting
+	selectedKey := t.selectedKey()
+
+	// Sort all rows first. Stable, so rows that compare equal don't trade
+	// places on every re-sort.
+	sort.SliceStable(t.rows, func(i, j int) bool {
 		var result bool
 		switch field {
 		case "namespace":
@@ -429,6 +744,40 @@ func (t *ServiceTable) SortBy(field string, ascending bool) {
 			} else {
 				result = t.rows[i].Port < t.rows[j].Port
 			}
+		case "age":
+			// Newest services first when ascending
+			if t.rows[i].CreatedAt.Equal(t.rows[j].CreatedAt) {
+				result = t.rows[i].Name < t.rows[j].Name
+				if t.rows[i].Name == t.rows[j].Name {
+					result = t.rows[i].Port < t.rows[j].Port
+				}
+			} else {
+				result = t.rows[i].CreatedAt.After(t.rows[j].CreatedAt)
+			}
+		case "started":
+			// Most recently started forwards first when ascending, then
+			// the rest by name
+			si, sj := rowStartedAt(t.rows[i]), rowStartedAt(t.rows[j])
+			if si.Equal(sj) {
+				result = t.rows[i].Name < t.rows[j].Name
+				if t.rows[i].Name == t.rows[j].Name {
+					result = t.rows[i].Port < t.rows[j].Port
+				}
+			} else {
+				result = si.After(sj)
+			}
+		case "failed":
+			// Failed forwards first when ascending, then the rest by name
+			fi := t.rows[i].ForwardingState == k8s.ForwardingStateFailed
+			fj := t.rows[j].ForwardingState == k8s.ForwardingStateFailed
+			if fi == fj {
+				result = t.rows[i].Name < t.rows[j].Name
+				if t.rows[i].Name == t.rows[j].Name {
+					result = t.rows[i].Port < t.rows[j].Port
+				}
+			} else {
+				result = fi
+			}
 		case "localport":
 			// Sort by forwarding port number
 			if t.rows[i].ForwardingPort == t.rows[j].ForwardingPort {
@@ -454,7 +803,7 @@ func (t *ServiceTable) SortBy(field string, ascending bool) {
 	
 	// Also sort filtered rows if filters are active
 	if len(t.filters) > 0 {
-		sort.Slice(t.filteredRows, func(i, j int) bool {
+		sort.SliceStable(t.filteredRows, func(i, j int) bool {
 			var result bool
 			switch field {
 			case "namespace":
@@ -487,6 +836,40 @@ func (t *ServiceTable) SortBy(field string, ascending bool) {
 				} else {
 					result = t.filteredRows[i].Port < t.filteredRows[j].Port
 				}
+			case "age":
+				// Newest services first when ascending
+				if t.filteredRows[i].CreatedAt.Equal(t.filteredRows[j].CreatedAt) {
+					result = t.filteredRows[i].Name < t.filteredRows[j].Name
+					if t.filteredRows[i].Name == t.filteredRows[j].Name {
+						result = t.filteredRows[i].Port < t.filteredRows[j].Port
+					}
+				} else {
+					result = t.filteredRows[i].CreatedAt.After(t.filteredRows[j].CreatedAt)
+				}
+			case "started":
+				// Most recently started forwards first when ascending, then
+				// the rest by name
+				si, sj := rowStartedAt(t.filteredRows[i]), rowStartedAt(t.filteredRows[j])
+				if si.Equal(sj) {
+					result = t.filteredRows[i].Name < t.filteredRows[j].Name
+					if t.filteredRows[i].Name == t.filteredRows[j].Name {
+						result = t.filteredRows[i].Port < t.filteredRows[j].Port
+					}
+				} else {
+					result = si.After(sj)
+				}
+			case "failed":
+				// Failed forwards first when ascending, then the rest by name
+				fi := t.filteredRows[i].ForwardingState == k8s.ForwardingStateFailed
+				fj := t.filteredRows[j].ForwardingState == k8s.ForwardingStateFailed
+				if fi == fj {
+					result = t.filteredRows[i].Name < t.filteredRows[j].Name
+					if t.filteredRows[i].Name == t.filteredRows[j].Name {
+						result = t.filteredRows[i].Port < t.filteredRows[j].Port
+					}
+				} else {
+					result = fi
+				}
 			case "localport":
 				// Sort by forwarding port number
 				if t.filteredRows[i].ForwardingPort == t.filteredRows[j].ForwardingPort {
@@ -511,12 +894,24 @@ func (t *ServiceTable) SortBy(field string, ascending bool) {
 		})
 	}
 	
+	// Keep the rows of each group together, in their sorted order
+	if t.groupLabel != "" {
+		t.groupRows(t.rows)
+		t.groupRows(t.filteredRows)
+	}
+	t.rebuildCollapsed()
+
 	// Clear and reset selection indicators
 	rows := t.getActiveRows()
 	for i := range rows {
 		rows[i].Selected = false
 	}
 	
+	// Follow the previously selected row to its new position
+	if index := indexOfRow(rows, selectedKey); selectedKey != "" && index >= 0 {
+		t.selectedRow = index
+	}
+	
 	// Reset selection to ensure valid index
 	if t.selectedRow >= len(rows) {
 		t.selectedRow = len(rows) - 1
@@ -529,17 +924,54 @@ func (t *ServiceTable) SortBy(field string, ascending bool) {
 	if len(rows) > 0 && t.selectedRow >= 0 && t.selectedRow < len(rows) {
 		rows[t.selectedRow].Selected = true
 	}
+	t.adjustScrollOffset()
 }
 
 // truncateString truncates a string to the specified length
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if runewidth.StringWidth(s) <= maxLen {
 		return s
 	}
 	if maxLen <= 3 {
-		return s[:maxLen]
+		return runewidth.Truncate(s, maxLen, "")
+	}
+	return runewidth.Truncate(s, maxLen, "…")
+}
+
+// formatColumns lays values out in space separated columns of the given
+// display widths, truncating values that don't fit, so wide or multibyte
+// characters keep the columns aligned
+func formatColumns(widths []int, values ...string) string {
+	cells := make([]string, len(values))
+	for i, value := range values {
+		if i < len(widths) {
+			value = padRight(truncateString(value, widths[i]), widths[i])
+		}
+		cells[i] = value
 	}
-	return s[:maxLen-1] + "…"
+	return strings.Join(cells, " ")
+}
+
+// renderCells formats a row like formatColumns, rendering the cells listed in
+// cellStyles with their own style and everything else with base
+func renderCells(widths []int, values []string, base lipgloss.Style, cellStyles map[int]lipgloss.Style) string {
+	if len(cellStyles) == 0 {
+		return base.Render(formatColumns(widths, values...))
+	}
+
+	var b strings.Builder
+	for i, value := range values {
+		if i > 0 {
+			b.WriteString(base.Render(" "))
+		}
+		cell := formatColumns(widths[min(i, len(widths)):], value)
+		if style, ok := cellStyles[i]; ok {
+			b.WriteString(style.Render(cell))
+		} else {
+			b.WriteString(base.Render(cell))
+		}
+	}
+	return b.String()
 }
 
 // compactServiceType converts service type to compact notation
@@ -558,27 +990,34 @@ func compactServiceType(serviceType string) string {
 	}
 }
 
-// ApplyFilters applies the given filters to the service table
+// ApplyFilters applies the given filters to the service table, keeping the
+// current selection if it still matches
 func (t *ServiceTable) ApplyFilters(filters map[string]string) {
+	t.applyFilters(filters, t.selectedKey(), rowKeys(t.getActiveRows()))
+}
+
+// applyFilters filters the rows and restores the selection to selectedKey. The
+// scroll offset is kept when the visible rows are the same as previousKeys.
+func (t *ServiceTable) applyFilters(filters map[string]string, selectedKey string, previousKeys []string) {
 	t.filters = filters
+	t.nameRegex, _ = compileNameFilter(filters["name"])
 	
 	// If no filters, show all rows
 	if len(filters) == 0 {
 		t.filteredRows = make([]ServiceTableRow, len(t.rows))
 		copy(t.filteredRows, t.rows)
-		t.adjustAfterFilter()
-		return
-	}
-	
-	// Filter rows based on active filters
-	t.filteredRows = []ServiceTableRow{}
-	for _, row := range t.rows {
-		if t.matchesFilters(row, filters) {
-			t.filteredRows = append(t.filteredRows, row)
+	} else {
+		// Filter rows based on active filters
+		t.filteredRows = []ServiceTableRow{}
+		for _, row := range t.rows {
+			if t.matchesFilters(row, filters) {
+				t.filteredRows = append(t.filteredRows, row)
+			}
 		}
 	}
+	t.rebuildCollapsed()
 	
-	t.adjustAfterFilter()
+	t.adjustAfterFilter(selectedKey, previousKeys)
 }
 
 // matchesFilters checks if a row matches all active filters
@@ -588,11 +1027,8 @@ func (t *ServiceTable) matchesFilters(row ServiceTableRow, filters map[string]st
 		
 		switch filterType {
 		case "status":
-			// Filter by forwarding status
-			if filterValue == "active" && row.ForwardingState != k8s.ForwardingStateActive {
-				return false
-			}
-			if filterValue == "inactive" && row.ForwardingState == k8s.ForwardingStateActive {
+			// Filter by forwarding status (active, pending, failed or inactive)
+			if row.ForwardingState.String() != filterValue {
 				return false
 			}
 			
@@ -603,8 +1039,18 @@ func (t *ServiceTable) matchesFilters(row ServiceTableRow, filters map[string]st
 			}
 			
 		case "name":
-			// Filter by service name (partial match)
-			if !strings.Contains(strings.ToLower(row.Name), filterValue) {
+			// Filter by service name (regex with a leading /, otherwise partial match)
+			if t.nameRegex != nil {
+				if !t.nameRegex.MatchString(row.Name) {
+					return false
+				}
+			} else if !strings.Contains(strings.ToLower(row.Name), strings.TrimPrefix(filterValue, "/")) {
+				return false
+			}
+			
+		case "namespace":
+			// Filter by namespace (exact match, suggestions are complete names)
+			if !strings.EqualFold(row.Namespace, filterValue) {
 				return false
 			}
 			
@@ -613,6 +1059,12 @@ func (t *ServiceTable) matchesFilters(row ServiceTableRow, filters map[string]st
 			if !strings.EqualFold(row.Protocol, filterValue) {
 				return false
 			}
+
+		case "forwardable":
+			// Filter by whether a forward of the row could succeed at all
+			if rowForwardable(row) != (filterValue == "true") {
+				return false
+			}
 			
 		default:
 			// Generic search across all fields
@@ -644,28 +1096,338 @@ func (t *ServiceTable) matchesFilters(row ServiceTableRow, filters map[string]st
 	return true
 }
 
-// adjustAfterFilter adjusts selection and scrolling after filtering
-func (t *ServiceTable) adjustAfterFilter() {
-	// Reset selection to first filtered row
-	if len(t.filteredRows) > 0 {
-		t.selectedRow = 0
-		// Clear all selected flags
-		for i := range t.filteredRows {
-			t.filteredRows[i].Selected = false
-		}
-		t.filteredRows[0].Selected = true
-	} else {
+// rowForwardable reports whether forwarding the row's port could possibly
+// work. Services without ports or endpoints, ExternalName services and
+// non-TCP ports are certain to fail.
+func rowForwardable(row ServiceTableRow) bool {
+	if row.PortInfo == nil || !row.PortInfo.Forwardable() || row.Type == "ExternalName" {
+		return false
+	}
+	_, noEndpoints := endpointsColumn(row.ServiceData)
+	return !noEndpoints
+}
+
+// rowStartedAt returns when the row's forward was started, zero for rows that
+// aren't forwarded
+func rowStartedAt(row ServiceTableRow) time.Time {
+	if row.PortInfo == nil || row.ForwardingState == k8s.ForwardingStateInactive {
+		return time.Time{}
+	}
+	return row.PortInfo.ForwardStartTime
+}
+
+// compileNameFilter compiles a name filter of the form /pattern. It returns a
+// nil regexp for plain substring filters and for patterns that fail to compile,
+// which fall back to substring matching.
+func compileNameFilter(value string) (*regexp.Regexp, error) {
+	if !strings.HasPrefix(value, "/") || len(value) < 2 {
+		return nil, nil
+	}
+	re, err := regexp.Compile(value[1:])
+	if err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+// adjustAfterFilter restores the selection to selectedKey (or the first row)
+// and only resets scrolling when the visible rows changed
+func (t *ServiceTable) adjustAfterFilter(selectedKey string, previousKeys []string) {
+	rows := t.getActiveRows()
+	if len(rows) == 0 {
 		t.selectedRow = -1
+		t.offset = 0
+		return
 	}
-	
-	// Reset scroll offset
-	t.offset = 0
+
+	t.selectedRow = max(indexOfRow(rows, selectedKey), 0)
+	for i := range rows {
+		rows[i].Selected = (i == t.selectedRow)
+	}
+
+	currentKeys := rowKeys(rows)
+	unchanged := len(currentKeys) == len(previousKeys)
+	for i := 0; unchanged && i < len(currentKeys); i++ {
+		unchanged = currentKeys[i] == previousKeys[i]
+	}
+	if !unchanged {
+		t.offset = 0
+	}
+	t.adjustScrollOffset()
+}
+
+// selectedKey returns the key of the selected row, or "" if nothing is selected
+func (t *ServiceTable) selectedKey() string {
+	rows := t.getActiveRows()
+	if t.selectedRow >= 0 && t.selectedRow < len(rows) {
+		return rowKey(rows[t.selectedRow])
+	}
+	return ""
+}
+
+// rowKey identifies a row by namespace, service name and port
+func rowKey(row ServiceTableRow) string {
+	return fmt.Sprintf("%s/%s:%d", row.Namespace, row.Name, row.Port)
 }
 
-// getActiveRows returns the currently active row set (filtered or all)
+// rowKeys returns the keys of rows in order
+func rowKeys(rows []ServiceTableRow) []string {
+	keys := make([]string, len(rows))
+	for i, row := range rows {
+		keys[i] = rowKey(row)
+	}
+	return keys
+}
+
+// ForEachRow calls fn for every row in both the full and filtered row sets
+func (t *ServiceTable) ForEachRow(fn func(row *ServiceTableRow)) {
+	for i := range t.rows {
+		fn(&t.rows[i])
+	}
+	for i := range t.filteredRows {
+		fn(&t.filteredRows[i])
+	}
+
+	// Collapsed rows summarize the updated rows
+	if t.collapsed {
+		t.reselect(t.rebuildCollapsed)
+	}
+}
+
+// getActiveRows returns the currently active row set (collapsed, filtered or all)
 func (t *ServiceTable) getActiveRows() []ServiceTableRow {
+	if t.collapsed {
+		return t.collapsedRows
+	}
+	return t.uncollapsedRows()
+}
+
+// uncollapsedRows returns the filtered rows, or all rows without filters
+func (t *ServiceTable) uncollapsedRows() []ServiceTableRow {
 	if len(t.filters) > 0 {
 		return t.filteredRows
 	}
 	return t.rows
-}
\ No newline at end of file
+}
+
+// SetCollapsed switches collapse mode on or off. Turning it on collapses
+// every service again.
+func (t *ServiceTable) SetCollapsed(collapsed bool) {
+	t.reselect(func() {
+		t.collapsed = collapsed
+		t.expanded = make(map[string]bool)
+		t.rebuildCollapsed()
+	})
+}
+
+// Collapsed reports whether collapse mode is on
+func (t *ServiceTable) Collapsed() bool {
+	return t.collapsed
+}
+
+// ExpandSelected shows the ports of the selected collapsed service as rows of
+// their own, selecting the first one. It reports whether a collapsed row was
+// selected.
+func (t *ServiceTable) ExpandSelected() bool {
+	row := t.GetSelectedRow()
+	if row == nil || !row.Collapsed {
+		return false
+	}
+	t.reselect(func() {
+		t.expanded[row.Namespace+"/"+row.Name] = true
+		t.rebuildCollapsed()
+	})
+	return true
+}
+
+// reselect runs change and then selects the row that was selected before, or
+// a row of the same service when that row was collapsed or expanded
+func (t *ServiceTable) reselect(change func()) {
+	selectedKey := t.selectedKey()
+	change()
+
+	rows := t.getActiveRows()
+	t.selectedRow = max(indexOfRow(rows, selectedKey), 0)
+	for i := range rows {
+		rows[i].Selected = (i == t.selectedRow)
+	}
+	t.adjustScrollOffset()
+}
+
+// rebuildCollapsed recomputes the rows of collapse mode from the filtered
+// rows: every service with several ports that isn't expanded becomes a
+// summary row, at the place of its first port
+func (t *ServiceTable) rebuildCollapsed() {
+	if !t.collapsed {
+		t.collapsedRows = nil
+		return
+	}
+
+	rows := t.uncollapsedRows()
+	ports := make(map[string][]ServiceTableRow)
+	for _, row := range rows {
+		service := row.Namespace + "/" + row.Name
+		ports[service] = append(ports[service], row)
+	}
+
+	t.collapsedRows = make([]ServiceTableRow, 0, len(ports))
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		service := row.Namespace + "/" + row.Name
+		if seen[service] {
+			continue
+		}
+		seen[service] = true
+
+		servicePorts := ports[service]
+		if len(servicePorts) == 1 || t.expanded[service] {
+			// Keep the ports of expanded services together
+			t.collapsedRows = append(t.collapsedRows, servicePorts...)
+			continue
+		}
+		t.collapsedRows = append(t.collapsedRows, summaryRow(servicePorts))
+	}
+}
+
+// summaryRow builds the collapsed row of a service from the rows of its ports.
+// Its state is the most notable one of the ports: active, pending, failed,
+// then inactive.
+func summaryRow(ports []ServiceTableRow) ServiceTableRow {
+	row := ports[0]
+	row.Collapsed = true
+	row.PortCount = len(ports)
+	row.PortName, row.Port, row.Protocol = "", 0, ""
+	row.ForwardingPort, row.PlannedPort = 0, 0
+	row.PortInfo = nil
+	row.Selected = false
+
+	row.ForwardingState = k8s.ForwardingStateInactive
+	for _, port := range ports {
+		if port.ForwardingState == k8s.ForwardingStateActive {
+			row.ActiveCount++
+		}
+		if summaryRank[port.ForwardingState] > summaryRank[row.ForwardingState] {
+			row.ForwardingState = port.ForwardingState
+		}
+	}
+	return row
+}
+
+// summaryRank orders the states a summary row may show, most notable last
+var summaryRank = map[k8s.ForwardingState]int{
+	k8s.ForwardingStateInactive: 0,
+	k8s.ForwardingStateFailed:   1,
+	k8s.ForwardingStatePending:  2,
+	k8s.ForwardingStateActive:   3,
+}
+
+// indexOfRow returns the index of the row with the given key, or else of the
+// first row of the same service, or -1
+func indexOfRow(rows []ServiceTableRow, key string) int {
+	for i, row := range rows {
+		if rowKey(row) == key {
+			return i
+		}
+	}
+	if i := strings.LastIndex(key, ":"); i >= 0 {
+		service := key[:i]
+		for j, row := range rows {
+			if row.Namespace+"/"+row.Name == service {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// ungroupedName heads the rows of services without the group label
+const ungroupedName = "ungrouped"
+
+// SetGroupLabel groups the rows by the value of a service label under section
+// headers, or stops grouping when label is empty. SortBy brings the rows of
+// each group together.
+func (t *ServiceTable) SetGroupLabel(label string) {
+	t.groupLabel = label
+}
+
+// GroupLabel returns the label the rows are grouped by, "" when not grouping
+func (t *ServiceTable) GroupLabel() string {
+	return t.groupLabel
+}
+
+// rowGroup returns the value of the group label of a row's service, or
+// ungroupedName without one
+func (t *ServiceTable) rowGroup(row ServiceTableRow) string {
+	if svc := row.ServiceData.Service; svc != nil {
+		if value := svc.Labels[t.groupLabel]; value != "" {
+			return value
+		}
+	}
+	return ungroupedName
+}
+
+// groupRows orders rows by group name, ungrouped rows last, keeping their
+// order within a group
+func (t *ServiceTable) groupRows(rows []ServiceTableRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		groupI, groupJ := t.rowGroup(rows[i]), t.rowGroup(rows[j])
+		if (groupI == ungroupedName) != (groupJ == ungroupedName) {
+			return groupJ == ungroupedName
+		}
+		return groupI < groupJ
+	})
+}
+
+// tableLine is a line of the table body: the row of that index, or the header
+// of the group below it when row is -1
+type tableLine struct {
+	row      int
+	group    string
+	services int // Services in the group, for headers
+}
+
+// bodyLines lays out rows as lines, with a header line above every group
+// when grouping
+func (t *ServiceTable) bodyLines(rows []ServiceTableRow) []tableLine {
+	lines := make([]tableLine, 0, len(rows))
+	header := -1
+	var services map[string]bool
+	for i, row := range rows {
+		if t.groupLabel != "" {
+			if group := t.rowGroup(row); i == 0 || group != t.rowGroup(rows[i-1]) {
+				header = len(lines)
+				services = make(map[string]bool)
+				lines = append(lines, tableLine{row: -1, group: group})
+			}
+			if service := row.Namespace + "/" + row.Name; !services[service] {
+				services[service] = true
+				lines[header].services++
+			}
+		}
+		lines = append(lines, tableLine{row: i})
+	}
+	return lines
+}
+
+// lineOfRow returns the index of the line showing row, or -1
+func lineOfRow(lines []tableLine, row int) int {
+	for i, line := range lines {
+		if line.row == row {
+			return i
+		}
+	}
+	return -1
+}
+
+// renderGroupHeader renders the section header line of a group
+func (t *ServiceTable) renderGroupHeader(line tableLine) string {
+	title := t.groupLabel + "=" + line.group
+	if line.group == ungroupedName {
+		title = ungroupedName
+	}
+	services := "services"
+	if line.services == 1 {
+		services = "service"
+	}
+	return sectionHeaderStyle.Render(truncateString(fmt.Sprintf("▾ %s (%d %s)", title, line.services, services), t.width))
+}
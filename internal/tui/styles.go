@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"hash/fnv"
+	"regexp"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -75,11 +77,32 @@ var (
 		Foreground(lipgloss.Color("#FF0000")).
 		Bold(true)
 
+	// Warning for data that may be out of date
+	staleStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF8800"))
+
 	successStyle = lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#00FF00")).
 		Bold(true)
 )
 
+// namespacePalette holds the muted colors namespaces are told apart by with
+// --color-by-namespace. None is close to the yellow of the selected row.
+var namespacePalette = []lipgloss.Color{
+	"#5FAFD7", "#87AF87", "#D787AF", "#AF87FF", "#5FD7AF", "#D7875F", "#87AFD7", "#AFAF5F",
+}
+
+// namespaceStyle returns the style of a namespace, the same one every time
+// for the same name
+func namespaceStyle(namespace string) lipgloss.Style {
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	return lipgloss.NewStyle().Foreground(namespacePalette[h.Sum32()%uint32(len(namespacePalette))])
+}
+
+// ansiPattern matches the SGR escape sequences lipgloss styles are made of
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
 // RenderWithFooter renders content with a footer positioned at the bottom of the terminal
 // This matches the admin UI footer implementation exactly
 func RenderWithFooter(content, footer string, width, height int) string {
@@ -89,7 +112,7 @@ func RenderWithFooter(content, footer string, width, height int) string {
 	
 	// Calculate available space for padding
 	footerHeight := 1 // Footer is one line
-	availableHeight := height - footerHeight
+	availableHeight := max(height-footerHeight, 0)
 	
 	// If content fits, pad with empty lines to push footer to bottom
 	if contentHeight < availableHeight {
@@ -119,7 +142,7 @@ func renderStyledFooter(text string, width int) string {
 	cmdColor := lipgloss.Color("#FFFF00")
 	
 	// First, pad the text to ensure it fills the width (accounting for padding)
-	effectiveWidth := width - 2 // -2 for left and right padding
+	effectiveWidth := max(width-2, 0) // -2 for left and right padding
 	if len(text) < effectiveWidth {
 		text = text + strings.Repeat(" ", effectiveWidth-len(text))
 	} else if len(text) > effectiveWidth {